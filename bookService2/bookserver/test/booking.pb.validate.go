@@ -0,0 +1,2039 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: booking.proto
+
+package test
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on Book with the rules defined in the proto
+// definition for this message. If any rules are violated, the first error
+// encountered is returned, or nil if there are no violations.
+func (m *Book) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Book with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in BookMultiError, or nil if none found.
+func (m *Book) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Book) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if l := utf8.RuneCountInString(m.GetTitle()); l < 1 || l > 256 {
+		err := BookValidationError{
+			field:  "Title",
+			reason: "value length must be between 1 and 256 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetAuthor()); l < 1 || l > 256 {
+		err := BookValidationError{
+			field:  "Author",
+			reason: "value length must be between 1 and 256 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetYear(); val <= 0 || val > 9999 {
+		err := BookValidationError{
+			field:  "Year",
+			reason: "value must be inside range (0, 9999]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_Book_Language_Pattern.MatchString(m.GetLanguage()) {
+		err := BookValidationError{
+			field:  "Language",
+			reason: "value does not match regex pattern \"^[A-Za-z]{2,3}(-[A-Za-z]{4})?(-([A-Za-z]{2}|[0-9]{3}))?$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetGenres()) > 20 {
+		err := BookValidationError{
+			field:  "Genres",
+			reason: "value must contain no more than 20 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetGenres() {
+		_, _ = idx, item
+
+		if l := utf8.RuneCountInString(item); l < 1 || l > 64 {
+			err := BookValidationError{
+				field:  fmt.Sprintf("Genres[%v]", idx),
+				reason: "value length must be between 1 and 64 runes, inclusive",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.GetPrice() < 0 {
+		err := BookValidationError{
+			field:  "Price",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetQuantity() < 0 {
+		err := BookValidationError{
+			field:  "Quantity",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetVersion() < 0 {
+		err := BookValidationError{
+			field:  "Version",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetIsbn10()) > 13 {
+		err := BookValidationError{
+			field:  "Isbn10",
+			reason: "value length must be at most 13 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_Book_Isbn10_Pattern.MatchString(m.GetIsbn10()) {
+		err := BookValidationError{
+			field:  "Isbn10",
+			reason: "value does not match regex pattern \"^[0-9Xx -]*$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetIsbn13()) > 17 {
+		err := BookValidationError{
+			field:  "Isbn13",
+			reason: "value length must be at most 17 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_Book_Isbn13_Pattern.MatchString(m.GetIsbn13()) {
+		err := BookValidationError{
+			field:  "Isbn13",
+			reason: "value does not match regex pattern \"^[0-9 -]*$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return BookMultiError(errors)
+	}
+
+	return nil
+}
+
+// BookMultiError is an error wrapping multiple validation errors returned by
+// Book.ValidateAll() if the designated constraints aren't met.
+type BookMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BookMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BookMultiError) AllErrors() []error { return m }
+
+// BookValidationError is the validation error returned by Book.Validate if the
+// designated constraints aren't met.
+type BookValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BookValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BookValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BookValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BookValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BookValidationError) ErrorName() string { return "BookValidationError" }
+
+// Error satisfies the builtin error interface
+func (e BookValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBook.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BookValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BookValidationError{}
+
+var _Book_Language_Pattern = regexp.MustCompile("^[A-Za-z]{2,3}(-[A-Za-z]{4})?(-([A-Za-z]{2}|[0-9]{3}))?$")
+
+var _Book_Isbn10_Pattern = regexp.MustCompile("^[0-9Xx -]*$")
+
+var _Book_Isbn13_Pattern = regexp.MustCompile("^[0-9 -]*$")
+
+// Validate checks the field values on CreateBookRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *CreateBookRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateBookRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateBookRequestMultiError, or nil if none found.
+func (m *CreateBookRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateBookRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetBook() == nil {
+		err := CreateBookRequestValidationError{
+			field:  "Book",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetBook()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateBookRequestValidationError{
+					field:  "Book",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateBookRequestValidationError{
+					field:  "Book",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBook()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateBookRequestValidationError{
+				field:  "Book",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateBookRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateBookRequestMultiError is an error wrapping multiple validation errors
+// returned by CreateBookRequest.ValidateAll() if the designated constraints
+// aren't met.
+type CreateBookRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateBookRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateBookRequestMultiError) AllErrors() []error { return m }
+
+// CreateBookRequestValidationError is the validation error returned by
+// CreateBookRequest.Validate if the designated constraints aren't met.
+type CreateBookRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateBookRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateBookRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateBookRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateBookRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateBookRequestValidationError) ErrorName() string {
+	return "CreateBookRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateBookRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateBookRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateBookRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateBookRequestValidationError{}
+
+// Validate checks the field values on ReadBookRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ReadBookRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReadBookRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReadBookRequestMultiError, or nil if none found.
+func (m *ReadBookRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReadBookRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() <= 0 {
+		err := ReadBookRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ReadBookRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReadBookRequestMultiError is an error wrapping multiple validation errors
+// returned by ReadBookRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ReadBookRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReadBookRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReadBookRequestMultiError) AllErrors() []error { return m }
+
+// ReadBookRequestValidationError is the validation error returned by
+// ReadBookRequest.Validate if the designated constraints aren't met.
+type ReadBookRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReadBookRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReadBookRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReadBookRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReadBookRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReadBookRequestValidationError) ErrorName() string { return "ReadBookRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ReadBookRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReadBookRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReadBookRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReadBookRequestValidationError{}
+
+// Validate checks the field values on UpdateBookRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *UpdateBookRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateBookRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateBookRequestMultiError, or nil if none found.
+func (m *UpdateBookRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateBookRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() <= 0 {
+		err := UpdateBookRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetBook() == nil {
+		err := UpdateBookRequestValidationError{
+			field:  "Book",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetBook()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateBookRequestValidationError{
+					field:  "Book",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateBookRequestValidationError{
+					field:  "Book",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBook()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateBookRequestValidationError{
+				field:  "Book",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdateMask()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateBookRequestValidationError{
+					field:  "UpdateMask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateBookRequestValidationError{
+					field:  "UpdateMask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdateMask()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateBookRequestValidationError{
+				field:  "UpdateMask",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateBookRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateBookRequestMultiError is an error wrapping multiple validation errors
+// returned by UpdateBookRequest.ValidateAll() if the designated constraints
+// aren't met.
+type UpdateBookRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateBookRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateBookRequestMultiError) AllErrors() []error { return m }
+
+// UpdateBookRequestValidationError is the validation error returned by
+// UpdateBookRequest.Validate if the designated constraints aren't met.
+type UpdateBookRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateBookRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateBookRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateBookRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateBookRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateBookRequestValidationError) ErrorName() string {
+	return "UpdateBookRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateBookRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateBookRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateBookRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateBookRequestValidationError{}
+
+// Validate checks the field values on DeleteBookRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *DeleteBookRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteBookRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteBookRequestMultiError, or nil if none found.
+func (m *DeleteBookRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteBookRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() <= 0 {
+		err := DeleteBookRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DeleteBookRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteBookRequestMultiError is an error wrapping multiple validation errors
+// returned by DeleteBookRequest.ValidateAll() if the designated constraints
+// aren't met.
+type DeleteBookRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteBookRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteBookRequestMultiError) AllErrors() []error { return m }
+
+// DeleteBookRequestValidationError is the validation error returned by
+// DeleteBookRequest.Validate if the designated constraints aren't met.
+type DeleteBookRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteBookRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteBookRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteBookRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteBookRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteBookRequestValidationError) ErrorName() string {
+	return "DeleteBookRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteBookRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteBookRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteBookRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteBookRequestValidationError{}
+
+// Validate checks the field values on DeleteBookResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteBookResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteBookResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteBookResponseMultiError, or nil if none found.
+func (m *DeleteBookResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteBookResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Success
+
+	if len(errors) > 0 {
+		return DeleteBookResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteBookResponseMultiError is an error wrapping multiple validation errors
+// returned by DeleteBookResponse.ValidateAll() if the designated constraints
+// aren't met.
+type DeleteBookResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteBookResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteBookResponseMultiError) AllErrors() []error { return m }
+
+// DeleteBookResponseValidationError is the validation error returned by
+// DeleteBookResponse.Validate if the designated constraints aren't met.
+type DeleteBookResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteBookResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteBookResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteBookResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteBookResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteBookResponseValidationError) ErrorName() string {
+	return "DeleteBookResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteBookResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteBookResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteBookResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteBookResponseValidationError{}
+
+// Validate checks the field values on UndeleteBookRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UndeleteBookRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UndeleteBookRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UndeleteBookRequestMultiError, or nil if none found.
+func (m *UndeleteBookRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UndeleteBookRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() <= 0 {
+		err := UndeleteBookRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return UndeleteBookRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UndeleteBookRequestMultiError is an error wrapping multiple validation
+// errors returned by UndeleteBookRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UndeleteBookRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UndeleteBookRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UndeleteBookRequestMultiError) AllErrors() []error { return m }
+
+// UndeleteBookRequestValidationError is the validation error returned by
+// UndeleteBookRequest.Validate if the designated constraints aren't met.
+type UndeleteBookRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UndeleteBookRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UndeleteBookRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UndeleteBookRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UndeleteBookRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UndeleteBookRequestValidationError) ErrorName() string {
+	return "UndeleteBookRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UndeleteBookRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUndeleteBookRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UndeleteBookRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UndeleteBookRequestValidationError{}
+
+// Validate checks the field values on GetBookByIsbnRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetBookByIsbnRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetBookByIsbnRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetBookByIsbnRequestMultiError, or nil if none found.
+func (m *GetBookByIsbnRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetBookByIsbnRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetIsbn()); l < 10 || l > 17 {
+		err := GetBookByIsbnRequestValidationError{
+			field:  "Isbn",
+			reason: "value length must be between 10 and 17 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetBookByIsbnRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetBookByIsbnRequestMultiError is an error wrapping multiple validation
+// errors returned by GetBookByIsbnRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetBookByIsbnRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetBookByIsbnRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetBookByIsbnRequestMultiError) AllErrors() []error { return m }
+
+// GetBookByIsbnRequestValidationError is the validation error returned by
+// GetBookByIsbnRequest.Validate if the designated constraints aren't met.
+type GetBookByIsbnRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetBookByIsbnRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetBookByIsbnRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetBookByIsbnRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetBookByIsbnRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetBookByIsbnRequestValidationError) ErrorName() string {
+	return "GetBookByIsbnRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetBookByIsbnRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetBookByIsbnRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetBookByIsbnRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetBookByIsbnRequestValidationError{}
+
+// Validate checks the field values on ListBooksRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListBooksRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListBooksRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListBooksRequestMultiError, or nil if none found.
+func (m *ListBooksRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListBooksRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Author
+
+	// no validation rules for Language
+
+	// no validation rules for Genre
+
+	if m.GetMinYear() < 0 {
+		err := ListBooksRequestValidationError{
+			field:  "MinYear",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetMaxYear() < 0 {
+		err := ListBooksRequestValidationError{
+			field:  "MaxYear",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetMinPrice() < 0 {
+		err := ListBooksRequestValidationError{
+			field:  "MinPrice",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetMaxPrice() < 0 {
+		err := ListBooksRequestValidationError{
+			field:  "MaxPrice",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for OrderBy
+
+	if m.GetPageSize() < 0 {
+		err := ListBooksRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for PageToken
+
+	if len(errors) > 0 {
+		return ListBooksRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListBooksRequestMultiError is an error wrapping multiple validation errors
+// returned by ListBooksRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListBooksRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListBooksRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListBooksRequestMultiError) AllErrors() []error { return m }
+
+// ListBooksRequestValidationError is the validation error returned by
+// ListBooksRequest.Validate if the designated constraints aren't met.
+type ListBooksRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListBooksRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListBooksRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListBooksRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListBooksRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListBooksRequestValidationError) ErrorName() string { return "ListBooksRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ListBooksRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListBooksRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListBooksRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListBooksRequestValidationError{}
+
+// Validate checks the field values on ListBooksResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListBooksResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListBooksResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListBooksResponseMultiError, or nil if none found.
+func (m *ListBooksResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListBooksResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetBooks() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListBooksResponseValidationError{
+						field:  fmt.Sprintf("Books[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListBooksResponseValidationError{
+						field:  fmt.Sprintf("Books[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListBooksResponseValidationError{
+					field:  fmt.Sprintf("Books[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return ListBooksResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListBooksResponseMultiError is an error wrapping multiple validation errors
+// returned by ListBooksResponse.ValidateAll() if the designated constraints
+// aren't met.
+type ListBooksResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListBooksResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListBooksResponseMultiError) AllErrors() []error { return m }
+
+// ListBooksResponseValidationError is the validation error returned by
+// ListBooksResponse.Validate if the designated constraints aren't met.
+type ListBooksResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListBooksResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListBooksResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListBooksResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListBooksResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListBooksResponseValidationError) ErrorName() string {
+	return "ListBooksResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListBooksResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListBooksResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListBooksResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListBooksResponseValidationError{}
+
+// Validate checks the field values on Comic with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Comic) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Comic with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in ComicMultiError, or nil if none found.
+func (m *Comic) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Comic) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Title
+
+	// no validation rules for Author
+
+	// no validation rules for Year
+
+	// no validation rules for Language
+
+	// no validation rules for Price
+
+	// no validation rules for Quantity
+
+	// no validation rules for Publisher
+
+	if len(errors) > 0 {
+		return ComicMultiError(errors)
+	}
+
+	return nil
+}
+
+// ComicMultiError is an error wrapping multiple validation errors returned by
+// Comic.ValidateAll() if the designated constraints aren't met.
+type ComicMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ComicMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ComicMultiError) AllErrors() []error { return m }
+
+// ComicValidationError is the validation error returned by Comic.Validate if
+// the designated constraints aren't met.
+type ComicValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ComicValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ComicValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ComicValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ComicValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ComicValidationError) ErrorName() string { return "ComicValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ComicValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sComic.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ComicValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ComicValidationError{}
+
+// Validate checks the field values on SearchCatalogRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SearchCatalogRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SearchCatalogRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SearchCatalogRequestMultiError, or nil if none found.
+func (m *SearchCatalogRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SearchCatalogRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetQ()); l < 1 || l > 256 {
+		err := SearchCatalogRequestValidationError{
+			field:  "Q",
+			reason: "value length must be between 1 and 256 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetPageSize() < 0 {
+		err := SearchCatalogRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for PageToken
+
+	if len(errors) > 0 {
+		return SearchCatalogRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SearchCatalogRequestMultiError is an error wrapping multiple validation
+// errors returned by SearchCatalogRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SearchCatalogRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SearchCatalogRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SearchCatalogRequestMultiError) AllErrors() []error { return m }
+
+// SearchCatalogRequestValidationError is the validation error returned by
+// SearchCatalogRequest.Validate if the designated constraints aren't met.
+type SearchCatalogRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchCatalogRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchCatalogRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchCatalogRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchCatalogRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchCatalogRequestValidationError) ErrorName() string {
+	return "SearchCatalogRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SearchCatalogRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchCatalogRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchCatalogRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchCatalogRequestValidationError{}
+
+// Validate checks the field values on CatalogItem with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *CatalogItem) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CatalogItem with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in CatalogItemMultiError, or
+// nil if none found.
+func (m *CatalogItem) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CatalogItem) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Score
+
+	// no validation rules for Snippet
+
+	switch v := m.Item.(type) {
+	case *CatalogItem_Book:
+		if v == nil {
+			err := CatalogItemValidationError{
+				field:  "Item",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetBook()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CatalogItemValidationError{
+						field:  "Book",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CatalogItemValidationError{
+						field:  "Book",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetBook()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CatalogItemValidationError{
+					field:  "Book",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *CatalogItem_Comic:
+		if v == nil {
+			err := CatalogItemValidationError{
+				field:  "Item",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetComic()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CatalogItemValidationError{
+						field:  "Comic",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CatalogItemValidationError{
+						field:  "Comic",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetComic()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CatalogItemValidationError{
+					field:  "Comic",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+
+	if len(errors) > 0 {
+		return CatalogItemMultiError(errors)
+	}
+
+	return nil
+}
+
+// CatalogItemMultiError is an error wrapping multiple validation errors
+// returned by CatalogItem.ValidateAll() if the designated constraints aren't met.
+type CatalogItemMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CatalogItemMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CatalogItemMultiError) AllErrors() []error { return m }
+
+// CatalogItemValidationError is the validation error returned by
+// CatalogItem.Validate if the designated constraints aren't met.
+type CatalogItemValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CatalogItemValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CatalogItemValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CatalogItemValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CatalogItemValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CatalogItemValidationError) ErrorName() string { return "CatalogItemValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CatalogItemValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCatalogItem.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CatalogItemValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CatalogItemValidationError{}
+
+// Validate checks the field values on SearchCatalogResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SearchCatalogResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SearchCatalogResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SearchCatalogResponseMultiError, or nil if none found.
+func (m *SearchCatalogResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SearchCatalogResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetResults() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SearchCatalogResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SearchCatalogResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SearchCatalogResponseValidationError{
+					field:  fmt.Sprintf("Results[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return SearchCatalogResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SearchCatalogResponseMultiError is an error wrapping multiple validation
+// errors returned by SearchCatalogResponse.ValidateAll() if the designated
+// constraints aren't met.
+type SearchCatalogResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SearchCatalogResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SearchCatalogResponseMultiError) AllErrors() []error { return m }
+
+// SearchCatalogResponseValidationError is the validation error returned by
+// SearchCatalogResponse.Validate if the designated constraints aren't met.
+type SearchCatalogResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchCatalogResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchCatalogResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchCatalogResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchCatalogResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchCatalogResponseValidationError) ErrorName() string {
+	return "SearchCatalogResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SearchCatalogResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchCatalogResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchCatalogResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchCatalogResponseValidationError{}
@@ -19,10 +19,14 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	BookingService_CreateBook_FullMethodName = "/booking.BookingService/CreateBook"
-	BookingService_ReadBook_FullMethodName   = "/booking.BookingService/ReadBook"
-	BookingService_UpdateBook_FullMethodName = "/booking.BookingService/UpdateBook"
-	BookingService_DeleteBook_FullMethodName = "/booking.BookingService/DeleteBook"
+	BookingService_CreateBook_FullMethodName    = "/booking.BookingService/CreateBook"
+	BookingService_ReadBook_FullMethodName      = "/booking.BookingService/ReadBook"
+	BookingService_UpdateBook_FullMethodName    = "/booking.BookingService/UpdateBook"
+	BookingService_DeleteBook_FullMethodName    = "/booking.BookingService/DeleteBook"
+	BookingService_UndeleteBook_FullMethodName  = "/booking.BookingService/UndeleteBook"
+	BookingService_GetBookByIsbn_FullMethodName = "/booking.BookingService/GetBookByIsbn"
+	BookingService_ListBooks_FullMethodName     = "/booking.BookingService/ListBooks"
+	BookingService_SearchCatalog_FullMethodName = "/booking.BookingService/SearchCatalog"
 )
 
 // BookingServiceClient is the client API for BookingService service.
@@ -33,6 +37,12 @@ type BookingServiceClient interface {
 	ReadBook(ctx context.Context, in *ReadBookRequest, opts ...grpc.CallOption) (*Book, error)
 	UpdateBook(ctx context.Context, in *UpdateBookRequest, opts ...grpc.CallOption) (*Book, error)
 	DeleteBook(ctx context.Context, in *DeleteBookRequest, opts ...grpc.CallOption) (*DeleteBookResponse, error)
+	// UndeleteBook restores a deleted book that has not been purged yet. It
+	// fails with ALREADY_EXISTS if a live book has taken its ISBN since.
+	UndeleteBook(ctx context.Context, in *UndeleteBookRequest, opts ...grpc.CallOption) (*Book, error)
+	GetBookByIsbn(ctx context.Context, in *GetBookByIsbnRequest, opts ...grpc.CallOption) (*Book, error)
+	ListBooks(ctx context.Context, in *ListBooksRequest, opts ...grpc.CallOption) (*ListBooksResponse, error)
+	SearchCatalog(ctx context.Context, in *SearchCatalogRequest, opts ...grpc.CallOption) (*SearchCatalogResponse, error)
 }
 
 type bookingServiceClient struct {
@@ -79,6 +89,42 @@ func (c *bookingServiceClient) DeleteBook(ctx context.Context, in *DeleteBookReq
 	return out, nil
 }
 
+func (c *bookingServiceClient) UndeleteBook(ctx context.Context, in *UndeleteBookRequest, opts ...grpc.CallOption) (*Book, error) {
+	out := new(Book)
+	err := c.cc.Invoke(ctx, BookingService_UndeleteBook_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) GetBookByIsbn(ctx context.Context, in *GetBookByIsbnRequest, opts ...grpc.CallOption) (*Book, error) {
+	out := new(Book)
+	err := c.cc.Invoke(ctx, BookingService_GetBookByIsbn_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) ListBooks(ctx context.Context, in *ListBooksRequest, opts ...grpc.CallOption) (*ListBooksResponse, error) {
+	out := new(ListBooksResponse)
+	err := c.cc.Invoke(ctx, BookingService_ListBooks_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) SearchCatalog(ctx context.Context, in *SearchCatalogRequest, opts ...grpc.CallOption) (*SearchCatalogResponse, error) {
+	out := new(SearchCatalogResponse)
+	err := c.cc.Invoke(ctx, BookingService_SearchCatalog_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // BookingServiceServer is the server API for BookingService service.
 // All implementations must embed UnimplementedBookingServiceServer
 // for forward compatibility
@@ -87,6 +133,12 @@ type BookingServiceServer interface {
 	ReadBook(context.Context, *ReadBookRequest) (*Book, error)
 	UpdateBook(context.Context, *UpdateBookRequest) (*Book, error)
 	DeleteBook(context.Context, *DeleteBookRequest) (*DeleteBookResponse, error)
+	// UndeleteBook restores a deleted book that has not been purged yet. It
+	// fails with ALREADY_EXISTS if a live book has taken its ISBN since.
+	UndeleteBook(context.Context, *UndeleteBookRequest) (*Book, error)
+	GetBookByIsbn(context.Context, *GetBookByIsbnRequest) (*Book, error)
+	ListBooks(context.Context, *ListBooksRequest) (*ListBooksResponse, error)
+	SearchCatalog(context.Context, *SearchCatalogRequest) (*SearchCatalogResponse, error)
 	mustEmbedUnimplementedBookingServiceServer()
 }
 
@@ -106,6 +158,18 @@ func (UnimplementedBookingServiceServer) UpdateBook(context.Context, *UpdateBook
 func (UnimplementedBookingServiceServer) DeleteBook(context.Context, *DeleteBookRequest) (*DeleteBookResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteBook not implemented")
 }
+func (UnimplementedBookingServiceServer) UndeleteBook(context.Context, *UndeleteBookRequest) (*Book, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UndeleteBook not implemented")
+}
+func (UnimplementedBookingServiceServer) GetBookByIsbn(context.Context, *GetBookByIsbnRequest) (*Book, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBookByIsbn not implemented")
+}
+func (UnimplementedBookingServiceServer) ListBooks(context.Context, *ListBooksRequest) (*ListBooksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBooks not implemented")
+}
+func (UnimplementedBookingServiceServer) SearchCatalog(context.Context, *SearchCatalogRequest) (*SearchCatalogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchCatalog not implemented")
+}
 func (UnimplementedBookingServiceServer) mustEmbedUnimplementedBookingServiceServer() {}
 
 // UnsafeBookingServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -191,6 +255,78 @@ func _BookingService_DeleteBook_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BookingService_UndeleteBook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UndeleteBookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).UndeleteBook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_UndeleteBook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).UndeleteBook(ctx, req.(*UndeleteBookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_GetBookByIsbn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBookByIsbnRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).GetBookByIsbn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_GetBookByIsbn_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).GetBookByIsbn(ctx, req.(*GetBookByIsbnRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_ListBooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBooksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).ListBooks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_ListBooks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).ListBooks(ctx, req.(*ListBooksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_SearchCatalog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchCatalogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).SearchCatalog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_SearchCatalog_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).SearchCatalog(ctx, req.(*SearchCatalogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // BookingService_ServiceDesc is the grpc.ServiceDesc for BookingService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -214,6 +350,22 @@ var BookingService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteBook",
 			Handler:    _BookingService_DeleteBook_Handler,
 		},
+		{
+			MethodName: "UndeleteBook",
+			Handler:    _BookingService_UndeleteBook_Handler,
+		},
+		{
+			MethodName: "GetBookByIsbn",
+			Handler:    _BookingService_GetBookByIsbn_Handler,
+		},
+		{
+			MethodName: "ListBooks",
+			Handler:    _BookingService_ListBooks_Handler,
+		},
+		{
+			MethodName: "SearchCatalog",
+			Handler:    _BookingService_SearchCatalog_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "booking.proto",
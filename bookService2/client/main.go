@@ -5,10 +5,10 @@ import (
 	"log"
 	"net/http"
 
-	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"google.golang.org/grpc"
 
 	pb "Booking/bookserver/test" // Update the import path
+	"common/gateway"
 )
 
 func main() {
@@ -16,7 +16,9 @@ func main() {
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
 
-	mux := runtime.NewServeMux()
+	// Versioned responses carry an ETag, and stale If-Match updates are
+	// answered with 412 Precondition Failed
+	mux := gateway.NewServeMux()
 	opts := []grpc.DialOption{grpc.WithInsecure()}
 
 	err := pb.RegisterBookingServiceHandlerFromEndpoint(ctx, mux, "localhost:50051", opts)
@@ -0,0 +1,66 @@
+// Package events defines the book lifecycle events published by the booking
+// server and how they are encoded on the wire.
+package events
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "Booking/bookserver/test"
+)
+
+const (
+	// Exchange is the topic exchange book events are published to. The
+	// routing key of every message is its event type.
+	Exchange = "book_events"
+
+	// ContentType describes the encoding of every event body.
+	ContentType = "application/json"
+
+	// SchemaVersion is the current version of pb.BookEvent.
+	SchemaVersion = 1
+)
+
+// Event types, which double as routing keys.
+const (
+	BookCreated  = "book.created"
+	BookUpdated  = "book.updated"
+	BookDeleted  = "book.deleted"
+	BookRestored = "book.restored"
+)
+
+// NewBookEvent returns an event of the given type describing a change from
+// before to after. before is nil for created books and after is nil for
+// deleted ones.
+func NewBookEvent(eventType string, before, after *pb.Book) *pb.BookEvent {
+	return &pb.BookEvent{
+		EventId:       uuid.NewString(),
+		Type:          eventType,
+		SchemaVersion: SchemaVersion,
+		OccurredAt:    timestamppb.New(time.Now()),
+		Before:        before,
+		After:         after,
+	}
+}
+
+// Marshal encodes an event as JSON.
+func Marshal(event *pb.BookEvent) ([]byte, error) {
+	return protojson.Marshal(event)
+}
+
+// Unmarshal decodes a JSON event. Unknown fields are ignored so consumers
+// keep working when newer fields are added within the same schema version.
+func Unmarshal(data []byte) (*pb.BookEvent, error) {
+	event := &pb.BookEvent{}
+	if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(data, event); err != nil {
+		return nil, err
+	}
+	if event.SchemaVersion > SchemaVersion {
+		return nil, fmt.Errorf("unsupported book event schema version %d", event.SchemaVersion)
+	}
+	return event, nil
+}
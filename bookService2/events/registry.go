@@ -0,0 +1,44 @@
+package events
+
+import (
+	"context"
+	"fmt"
+
+	pb "Booking/bookserver/test"
+)
+
+// Handler processes a single event. Returning an error makes the consumer
+// retry the message and dead-letter it once the retries run out, so handlers
+// must tolerate seeing the same event more than once.
+type Handler func(ctx context.Context, event *pb.BookEvent) error
+
+// Registry maps event types to the handlers interested in them.
+type Registry struct {
+	handlers map[string][]Handler
+}
+
+func NewRegistry() *Registry {
+	return &Registry{handlers: make(map[string][]Handler)}
+}
+
+// Register adds a handler for events of the given type. Handlers for the same
+// type run in the order they were registered.
+func (r *Registry) Register(eventType string, handler Handler) {
+	r.handlers[eventType] = append(r.handlers[eventType], handler)
+}
+
+// Handles reports whether any handler is registered for eventType.
+func (r *Registry) Handles(eventType string) bool {
+	return len(r.handlers[eventType]) > 0
+}
+
+// Dispatch runs every handler registered for the event's type and stops at
+// the first failure.
+func (r *Registry) Dispatch(ctx context.Context, event *pb.BookEvent) error {
+	for _, handler := range r.handlers[event.GetType()] {
+		if err := handler(ctx, event); err != nil {
+			return fmt.Errorf("handle %s event %s: %w", event.GetType(), event.GetEventId(), err)
+		}
+	}
+	return nil
+}
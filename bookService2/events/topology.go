@@ -0,0 +1,48 @@
+package events
+
+import "common/broker"
+
+const (
+	// DeadLetterExchange receives the messages consumers rejected.
+	DeadLetterExchange = "book_events.dlx"
+
+	// Queues receiving each event type. Books used to be announced by
+	// publishing their bare id to book_creation_queue, which was declared
+	// without a dead letter exchange. RabbitMQ refuses to redeclare a queue
+	// with other arguments, so events go to a queue of their own; the old
+	// one can be deleted once its consumers have drained it.
+	CreationQueue = "book_addition_queue"
+	UpdateQueue   = "book_update_queue"
+	DeletionQueue = "book_deletion_queue"
+)
+
+// Topology is the RabbitMQ topology book events flow through. Every event
+// type is bound to a queue, so publishing with mandatory routing only fails
+// when the topology is missing.
+var Topology = broker.Topology{
+	Exchanges: []broker.Exchange{
+		{Name: Exchange, Kind: broker.ExchangeTopic},
+		{Name: DeadLetterExchange, Kind: broker.ExchangeDirect},
+	},
+	Queues: []broker.Queue{
+		{
+			// A restored book reappears in the catalog just like a new one
+			Name: CreationQueue,
+			Bindings: []broker.Binding{
+				{Exchange: Exchange, Key: BookCreated},
+				{Exchange: Exchange, Key: BookRestored},
+			},
+			DeadLetterExchange: DeadLetterExchange,
+		},
+		{
+			Name:               UpdateQueue,
+			Bindings:           []broker.Binding{{Exchange: Exchange, Key: BookUpdated}},
+			DeadLetterExchange: DeadLetterExchange,
+		},
+		{
+			Name:               DeletionQueue,
+			Bindings:           []broker.Binding{{Exchange: Exchange, Key: BookDeleted}},
+			DeadLetterExchange: DeadLetterExchange,
+		},
+	},
+}
@@ -0,0 +1,137 @@
+// Package isbn normalises and validates International Standard Book Numbers
+// and converts between their 10 and 13 digit forms.
+package isbn
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrNot978 is returned when converting an ISBN-13 outside the 978 prefix,
+// which has no ISBN-10 form.
+var ErrNot978 = errors.New("isbn: only 978-prefixed ISBN-13s have an ISBN-10 form")
+
+// Normalize strips the hyphens and spaces ISBNs are often printed with and
+// upper-cases a trailing check character X.
+func Normalize(s string) string {
+	s = strings.NewReplacer("-", "", " ", "").Replace(s)
+	return strings.ToUpper(s)
+}
+
+// Valid10 reports whether s is a normalised ISBN-10 with a correct check
+// digit.
+func Valid10(s string) bool {
+	if len(s) != 10 {
+		return false
+	}
+	for i := 0; i < 9; i++ {
+		if !isDigit(s[i]) {
+			return false
+		}
+	}
+	if !isDigit(s[9]) && s[9] != 'X' {
+		return false
+	}
+	return check10(s[:9]) == s[9]
+}
+
+// Valid13 reports whether s is a normalised ISBN-13 with a correct check
+// digit.
+func Valid13(s string) bool {
+	if len(s) != 13 {
+		return false
+	}
+	for i := 0; i < 13; i++ {
+		if !isDigit(s[i]) {
+			return false
+		}
+	}
+	return check13(s[:12]) == s[12]
+}
+
+// To13 converts a valid ISBN-10 to its ISBN-13 form.
+func To13(isbn10 string) string {
+	body := "978" + isbn10[:9]
+	return body + string(check13(body))
+}
+
+// To10 converts a valid ISBN-13 to its ISBN-10 form.
+func To10(isbn13 string) (string, error) {
+	if !strings.HasPrefix(isbn13, "978") {
+		return "", ErrNot978
+	}
+	body := isbn13[3:12]
+	return body + string(check10(body)), nil
+}
+
+// Resolve normalises and validates a book's ISBNs and fills in whichever
+// form is missing. Either may be empty; if both are given they must name
+// the same book. An ISBN-13 outside the 978 prefix leaves isbn10 empty.
+func Resolve(isbn10, isbn13 string) (string, string, error) {
+	isbn10, isbn13 = Normalize(isbn10), Normalize(isbn13)
+	if isbn10 != "" && !Valid10(isbn10) {
+		return "", "", fmt.Errorf("isbn10 %q is not a valid ISBN-10", isbn10)
+	}
+	if isbn13 != "" && !Valid13(isbn13) {
+		return "", "", fmt.Errorf("isbn13 %q is not a valid ISBN-13", isbn13)
+	}
+
+	switch {
+	case isbn10 != "" && isbn13 == "":
+		isbn13 = To13(isbn10)
+	case isbn13 != "" && isbn10 == "":
+		if converted, err := To10(isbn13); err == nil {
+			isbn10 = converted
+		}
+	case isbn10 != "" && isbn13 != "":
+		if To13(isbn10) != isbn13 {
+			return "", "", fmt.Errorf("isbn10 %s and isbn13 %s are different books", isbn10, isbn13)
+		}
+	}
+	return isbn10, isbn13, nil
+}
+
+// Parse normalises an ISBN in either form and returns both forms.
+func Parse(s string) (string, string, error) {
+	n := Normalize(s)
+	switch len(n) {
+	case 10:
+		return Resolve(n, "")
+	case 13:
+		return Resolve("", n)
+	default:
+		return "", "", fmt.Errorf("%q is not an ISBN", s)
+	}
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+// check10 computes the ISBN-10 check character of nine digits.
+func check10(body string) byte {
+	sum := 0
+	for i := 0; i < 9; i++ {
+		sum += (10 - i) * int(body[i]-'0')
+	}
+	switch c := (11 - sum%11) % 11; c {
+	case 10:
+		return 'X'
+	default:
+		return byte('0' + c)
+	}
+}
+
+// check13 computes the ISBN-13 check digit of twelve digits.
+func check13(body string) byte {
+	sum := 0
+	for i := 0; i < 12; i++ {
+		weight := 1
+		if i%2 == 1 {
+			weight = 3
+		}
+		sum += weight * int(body[i]-'0')
+	}
+	return byte('0' + (10-sum%10)%10)
+}
@@ -0,0 +1,118 @@
+package isbn
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestValid(t *testing.T) {
+	tests := []struct {
+		isbn string
+		want bool
+	}{
+		{"0306406152", true},
+		{"080442957X", true},
+		{"0306406153", false},
+		{"0804429579", false},
+		{"030640615X", false},
+		{"03064061", false},
+		{"9780306406157", true},
+		{"9791034304127", true},
+		{"9780306406158", false},
+		{"978030640615X", false},
+		{"978-0306406157", false},
+	}
+	for _, tt := range tests {
+		var got bool
+		if len(tt.isbn) == 10 {
+			got = Valid10(tt.isbn)
+		} else {
+			got = Valid13(tt.isbn)
+		}
+		if got != tt.want {
+			t.Errorf("valid(%q) = %v, want %v", tt.isbn, got, tt.want)
+		}
+	}
+}
+
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		in, want string
+	}{
+		{"0-306-40615-2", "0306406152"},
+		{"0 8044 2957 x", "080442957X"},
+		{"978-0-306-40615-7", "9780306406157"},
+		{"080442957x", "080442957X"},
+	}
+	for _, tt := range tests {
+		if got := Normalize(tt.in); got != tt.want {
+			t.Errorf("Normalize(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestTo10(t *testing.T) {
+	tests := []struct {
+		isbn13  string
+		want    string
+		wantErr error
+	}{
+		{"9780306406157", "0306406152", nil},
+		{"9780804429573", "080442957X", nil},
+		{"9791034304127", "", ErrNot978},
+	}
+	for _, tt := range tests {
+		got, err := To10(tt.isbn13)
+		if got != tt.want || !errors.Is(err, tt.wantErr) {
+			t.Errorf("To10(%q) = %q, %v; want %q, %v", tt.isbn13, got, err, tt.want, tt.wantErr)
+		}
+	}
+}
+
+func TestResolve(t *testing.T) {
+	tests := []struct {
+		name           string
+		isbn10, isbn13 string
+		want10, want13 string
+		wantErr        bool
+	}{
+		{"both empty", "", "", "", "", false},
+		{"isbn10 only", "0-306-40615-2", "", "0306406152", "9780306406157", false},
+		{"isbn13 only", "", "978-0-306-40615-7", "0306406152", "9780306406157", false},
+		{"lowercase x", "0-8044-2957-x", "", "080442957X", "9780804429573", false},
+		{"979 prefix", "", "979-10-343-0412-7", "", "9791034304127", false},
+		{"matching pair", "0306406152", "9780306406157", "0306406152", "9780306406157", false},
+		{"mismatched pair", "0306406152", "9780804429573", "", "", true},
+		{"bad isbn10", "0306406153", "", "", "", true},
+		{"bad isbn13", "", "9780306406158", "", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got10, got13, err := Resolve(tt.isbn10, tt.isbn13)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Resolve(%q, %q) error = %v, want error %v", tt.isbn10, tt.isbn13, err, tt.wantErr)
+			}
+			if got10 != tt.want10 || got13 != tt.want13 {
+				t.Errorf("Resolve(%q, %q) = %q, %q; want %q, %q", tt.isbn10, tt.isbn13, got10, got13, tt.want10, tt.want13)
+			}
+		})
+	}
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		in             string
+		want10, want13 string
+		wantErr        bool
+	}{
+		{"0-306-40615-2", "0306406152", "9780306406157", false},
+		{"978 0 306 40615 7", "0306406152", "9780306406157", false},
+		{"12345", "", "", true},
+	}
+	for _, tt := range tests {
+		got10, got13, err := Parse(tt.in)
+		if (err != nil) != tt.wantErr || got10 != tt.want10 || got13 != tt.want13 {
+			t.Errorf("Parse(%q) = %q, %q, %v; want %q, %q, error %v", tt.in, got10, got13, err, tt.want10, tt.want13, tt.wantErr)
+		}
+	}
+}
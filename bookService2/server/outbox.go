@@ -0,0 +1,211 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+
+	pb "Booking/bookserver/test"
+	"Booking/events"
+	"common/broker"
+)
+
+const (
+	outboxPollInterval = time.Second
+	outboxBatchSize    = 100
+	outboxMinBackoff   = time.Second
+	outboxMaxBackoff   = 5 * time.Minute
+
+	// outboxConfirmTimeout bounds how long the relay waits for the broker
+	// to confirm a single message.
+	outboxConfirmTimeout = 10 * time.Second
+)
+
+// enqueueOutbox records a message in book_outbox. It must be called with the
+// transaction that performs the change the message describes, so the message
+// exists if and only if the change was committed.
+func enqueueOutbox(ctx context.Context, tx pgx.Tx, eventType, exchange, routingKey string, payload []byte) error {
+	_, err := tx.Exec(ctx, `
+		INSERT INTO book_outbox (event_type, exchange, routing_key, payload)
+		VALUES ($1, $2, $3, $4)
+	`, eventType, exchange, routingKey, payload)
+	return err
+}
+
+// enqueueBookEvent records a book lifecycle event in the outbox, routed by
+// its type on the book events exchange.
+func enqueueBookEvent(ctx context.Context, tx pgx.Tx, eventType string, before, after *pb.Book) error {
+	payload, err := events.Marshal(events.NewBookEvent(eventType, before, after))
+	if err != nil {
+		return err
+	}
+	return enqueueOutbox(ctx, tx, eventType, events.Exchange, eventType, payload)
+}
+
+// outboxRelay publishes pending book_outbox rows to the broker and marks them
+// sent. A row is only marked once the broker confirmed it was routed to a
+// queue, so every message is delivered at least once; unroutable, nacked and
+// failed rows are retried with exponential backoff.
+type outboxRelay struct {
+	db        *pgxpool.Pool
+	publisher broker.Publisher
+	wake      chan struct{}
+}
+
+func newOutboxRelay(db *pgxpool.Pool, publisher broker.Publisher) *outboxRelay {
+	return &outboxRelay{
+		db:        db,
+		publisher: publisher,
+		wake:      make(chan struct{}, 1),
+	}
+}
+
+// notify asks the relay to look for pending rows now instead of waiting for
+// the next poll.
+func (r *outboxRelay) notify() {
+	select {
+	case r.wake <- struct{}{}:
+	default:
+	}
+}
+
+// run relays pending rows until ctx is cancelled.
+func (r *outboxRelay) run(ctx context.Context) {
+	ticker := time.NewTicker(outboxPollInterval)
+	defer ticker.Stop()
+
+	for {
+		for {
+			n, err := r.relayBatch(ctx)
+			if err != nil {
+				log.Printf("Failed to relay outbox: %v", err)
+			}
+			// Keep draining while full batches come back
+			if err != nil || n < outboxBatchSize {
+				break
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-r.wake:
+		}
+	}
+}
+
+// relayBatch publishes up to outboxBatchSize due rows and returns how many it
+// picked up. It stops at the first row the broker could not be reached for and
+// returns an error. Rows are locked with SKIP LOCKED so several server
+// instances can relay from the same table without publishing a row twice at
+// once.
+func (r *outboxRelay) relayBatch(ctx context.Context) (int, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback(ctx)
+
+	rows, err := tx.Query(ctx, `
+		SELECT id, event_type, exchange, routing_key, payload, attempts
+		FROM book_outbox
+		WHERE sent_at IS NULL AND next_attempt_at <= now()
+		ORDER BY id
+		LIMIT $1
+		FOR UPDATE SKIP LOCKED
+	`, outboxBatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	type outboxMessage struct {
+		id         int64
+		eventType  string
+		exchange   string
+		routingKey string
+		payload    []byte
+		attempts   int
+	}
+	var messages []outboxMessage
+	for rows.Next() {
+		var m outboxMessage
+		if err := rows.Scan(&m.id, &m.eventType, &m.exchange, &m.routingKey, &m.payload, &m.attempts); err != nil {
+			rows.Close()
+			return 0, err
+		}
+		messages = append(messages, m)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return 0, err
+	}
+	if len(messages) == 0 {
+		return 0, nil
+	}
+
+	// Once the broker is unreachable the rest of the batch is left for the
+	// next poll; only rows that were actually tried are marked
+	var brokerErr error
+	for _, m := range messages {
+		publishCtx, cancel := context.WithTimeout(ctx, outboxConfirmTimeout)
+		err := r.publisher.Publish(publishCtx, m.exchange, m.routingKey, broker.Message{
+			ID:          fmt.Sprintf("%d", m.id),
+			Type:        m.eventType,
+			ContentType: events.ContentType,
+			Body:        m.payload,
+		})
+		cancel()
+		if errors.Is(err, broker.ErrDisconnected) || errors.Is(err, context.DeadlineExceeded) {
+			brokerErr = err
+		}
+
+		if err != nil {
+			log.Printf("Failed to publish outbox message %d: %v", m.id, err)
+			_, err = tx.Exec(ctx, `
+				UPDATE book_outbox
+				SET attempts = attempts + 1, last_error = $2, next_attempt_at = now() + $3 * interval '1 second'
+				WHERE id = $1
+			`, m.id, err.Error(), outboxBackoff(m.attempts+1).Seconds())
+		} else {
+			_, err = tx.Exec(ctx, `
+				UPDATE book_outbox
+				SET attempts = attempts + 1, last_error = NULL, sent_at = now()
+				WHERE id = $1
+			`, m.id)
+		}
+		if err != nil {
+			return 0, err
+		}
+		if brokerErr != nil {
+			break
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return 0, err
+	}
+	// Report the broker as failing, so run waits before trying again
+	if brokerErr != nil {
+		return len(messages), fmt.Errorf("broker unavailable: %w", brokerErr)
+	}
+	return len(messages), nil
+}
+
+// outboxBackoff returns how long to wait before retrying a message that has
+// failed the given number of times.
+func outboxBackoff(attempts int) time.Duration {
+	backoff := outboxMinBackoff
+	for i := 1; i < attempts && backoff < outboxMaxBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > outboxMaxBackoff {
+		backoff = outboxMaxBackoff
+	}
+	return backoff
+}
@@ -0,0 +1,27 @@
+package main
+
+import (
+	pb "Booking/bookserver/test"
+	"common/auth"
+
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// catalogEditors may change the catalog.
+var catalogEditors = auth.AnyPermission(auth.PermissionCatalogWrite)
+
+// policy says who may call each method. Reading the catalog is open to
+// everyone; changing it takes an editor.
+var policy = auth.Policy{
+	pb.BookingService_ReadBook_FullMethodName:      auth.Public,
+	pb.BookingService_GetBookByIsbn_FullMethodName: auth.Public,
+	pb.BookingService_ListBooks_FullMethodName:     auth.Public,
+	pb.BookingService_SearchCatalog_FullMethodName: auth.Public,
+
+	pb.BookingService_CreateBook_FullMethodName:   catalogEditors,
+	pb.BookingService_UpdateBook_FullMethodName:   catalogEditors,
+	pb.BookingService_DeleteBook_FullMethodName:   catalogEditors,
+	pb.BookingService_UndeleteBook_FullMethodName: catalogEditors,
+
+	healthpb.Health_Check_FullMethodName: auth.Public,
+}
@@ -0,0 +1,53 @@
+package main
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+const (
+	// deletedBookRetention is how long a deleted book can be restored
+	// before it is removed for good.
+	deletedBookRetention = 30 * 24 * time.Hour
+
+	// sentOutboxRetention is how long relayed outbox rows are kept for
+	// troubleshooting.
+	sentOutboxRetention = 7 * 24 * time.Hour
+
+	purgeInterval = time.Hour
+)
+
+// purgeExpired hard-deletes books deleted longer ago than the retention
+// window and outbox rows relayed longer ago than theirs, once now and then
+// every purgeInterval until ctx is done.
+func purgeExpired(ctx context.Context, db *pgxpool.Pool) {
+	ticker := time.NewTicker(purgeInterval)
+	defer ticker.Stop()
+
+	statements := []struct {
+		what, sql string
+		retention time.Duration
+	}{
+		{"deleted books", `DELETE FROM books WHERE deleted_at < now() - $1 * interval '1 second'`, deletedBookRetention},
+		{"sent outbox messages", `DELETE FROM book_outbox WHERE sent_at < now() - $1 * interval '1 second'`, sentOutboxRetention},
+	}
+	for {
+		for _, stmt := range statements {
+			tag, err := db.Exec(ctx, stmt.sql, stmt.retention.Seconds())
+			if err != nil {
+				log.Printf("Failed to purge %s: %v", stmt.what, err)
+			} else if n := tag.RowsAffected(); n > 0 {
+				log.Printf("Purged %d %s", n, stmt.what)
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
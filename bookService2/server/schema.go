@@ -0,0 +1,70 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+// schema holds the statements that bring an existing bookstore database up
+// to date with this server. Every statement must be safe to run on each
+// start, so they only ever create what is missing.
+var schema = []string{
+	`ALTER TABLE books ADD COLUMN IF NOT EXISTS version INTEGER NOT NULL DEFAULT 1`,
+	`ALTER TABLE books ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMPTZ`,
+	`ALTER TABLE books ADD COLUMN IF NOT EXISTS deleted_by TEXT`,
+	`ALTER TABLE books ADD COLUMN IF NOT EXISTS isbn10 TEXT`,
+	`ALTER TABLE books ADD COLUMN IF NOT EXISTS isbn13 TEXT`,
+	// Only live books must have distinct ISBNs, so a book can be added again
+	// after being deleted. Deleted books keep theirs, and restoring one fails
+	// if a live book has taken them since
+	`DROP INDEX IF EXISTS books_isbn10_key`,
+	`DROP INDEX IF EXISTS books_isbn13_key`,
+	`CREATE UNIQUE INDEX IF NOT EXISTS books_live_isbn10_key ON books (isbn10) WHERE deleted_at IS NULL`,
+	`CREATE UNIQUE INDEX IF NOT EXISTS books_live_isbn13_key ON books (isbn13) WHERE deleted_at IS NULL`,
+	`CREATE INDEX IF NOT EXISTS books_deleted_at_idx
+		ON books (deleted_at)
+		WHERE deleted_at IS NOT NULL`,
+	// array_to_string is only stable, which generated columns do not accept;
+	// for text arrays it cannot change between calls
+	`CREATE OR REPLACE FUNCTION catalog_words(text[]) RETURNS text
+		LANGUAGE sql IMMUTABLE PARALLEL SAFE
+		AS $$ SELECT array_to_string($1, ' ') $$`,
+	// SearchCatalog matches against search_vector: title words weigh most,
+	// then author, then genres
+	`ALTER TABLE books ADD COLUMN IF NOT EXISTS search_vector tsvector
+		GENERATED ALWAYS AS (
+			setweight(to_tsvector('simple', coalesce(title, '')), 'A') ||
+			setweight(to_tsvector('simple', coalesce(author, '')), 'B') ||
+			setweight(to_tsvector('simple', coalesce(catalog_words(genres), '')), 'C')
+		) STORED`,
+	`CREATE INDEX IF NOT EXISTS books_search_vector_idx ON books USING GIN (search_vector)`,
+	`CREATE TABLE IF NOT EXISTS book_outbox (
+		id              BIGSERIAL PRIMARY KEY,
+		event_type      TEXT NOT NULL,
+		exchange        TEXT NOT NULL,
+		routing_key     TEXT NOT NULL,
+		payload         BYTEA NOT NULL,
+		created_at      TIMESTAMPTZ NOT NULL DEFAULT now(),
+		attempts        INTEGER NOT NULL DEFAULT 0,
+		next_attempt_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+		last_error      TEXT,
+		sent_at         TIMESTAMPTZ
+	)`,
+	`CREATE INDEX IF NOT EXISTS book_outbox_pending_idx
+		ON book_outbox (next_attempt_at)
+		WHERE sent_at IS NULL`,
+	`CREATE INDEX IF NOT EXISTS book_outbox_sent_at_idx
+		ON book_outbox (sent_at)
+		WHERE sent_at IS NOT NULL`,
+}
+
+func migrate(ctx context.Context, db *pgxpool.Pool) error {
+	for _, stmt := range schema {
+		if _, err := db.Exec(ctx, stmt); err != nil {
+			return fmt.Errorf("apply schema: %w", err)
+		}
+	}
+	return nil
+}
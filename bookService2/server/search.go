@@ -0,0 +1,208 @@
+package main
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"log"
+	"strings"
+	"sync/atomic"
+	"unicode"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "Booking/bookserver/test"
+)
+
+// searchSQL ranks books, and comics if withComics is set, against a
+// tsquery. Both tables keep a weighted search_vector with a GIN index, so
+// only matching rows are read. Snippets are HTML: the text is escaped before
+// the matches are wrapped in <b></b>, so titles cannot inject markup into
+// the pages showing them.
+func searchSQL(withComics bool) string {
+	documents := `
+		SELECT 'book' AS kind, id, title, author, year, language, genres, price, quantity, '' AS publisher, version,
+			coalesce(isbn10, '') AS isbn10, coalesce(isbn13, '') AS isbn13,
+			concat_ws(' ', title, author, array_to_string(genres, ' ')) AS body,
+			search_vector AS document
+		FROM books
+		WHERE deleted_at IS NULL AND search_vector @@ to_tsquery('simple', $1)
+	`
+	if withComics {
+		documents += `
+		UNION ALL
+		SELECT 'comic', id, title, author, year, language, '{}'::text[], price, quantity, publisher, 0, '', '',
+			concat_ws(' ', title, author, publisher),
+			search_vector
+		FROM comics
+		WHERE deleted_at IS NULL AND search_vector @@ to_tsquery('simple', $1)
+	`
+	}
+	return `
+	WITH matches AS (` + documents + `)
+	SELECT kind, id, title, author, year, language, genres, price, quantity, publisher, version, isbn10, isbn13,
+		ts_rank(document, query) AS score,
+		ts_headline('simple', replace(replace(replace(body, '&', '&amp;'), '<', '&lt;'), '>', '&gt;'), query,
+			'StartSel=<b>, StopSel=</b>, MinWords=15, MaxWords=35, MaxFragments=3')
+	FROM matches, to_tsquery('simple', $1) AS query
+	ORDER BY score DESC, kind, id
+	LIMIT $2 OFFSET $3
+`
+}
+
+// comicsSearchable reports whether the comics server has added the columns
+// SearchCatalog needs to the comics table, which it owns. Until it has,
+// only books are searched. Once the columns are there the answer is kept.
+func (s *server) comicsSearchable(ctx context.Context) (bool, error) {
+	if atomic.LoadInt32(&s.comicsMigrated) == 1 {
+		return true, nil
+	}
+	var migrated bool
+	err := s.db.QueryRow(ctx, `
+		SELECT count(*) = 2
+		FROM information_schema.columns
+		WHERE table_schema = current_schema() AND table_name = 'comics'
+			AND column_name IN ('deleted_at', 'search_vector')
+	`).Scan(&migrated)
+	if err != nil {
+		return false, err
+	}
+	if migrated {
+		atomic.StoreInt32(&s.comicsMigrated, 1)
+	}
+	return migrated, nil
+}
+
+// searchPageToken is the decoded form of a SearchCatalog page token.
+type searchPageToken struct {
+	Query  string `json:"q"`
+	Offset int    `json:"n"`
+}
+
+// prefixQuery turns free text into a tsquery that requires every word and
+// matches words that merely start with what the user typed.
+func prefixQuery(q string) string {
+	words := strings.FieldsFunc(strings.ToLower(q), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	for i, word := range words {
+		words[i] = word + ":*"
+	}
+	return strings.Join(words, " & ")
+}
+
+func (s *server) SearchCatalog(ctx context.Context, req *pb.SearchCatalogRequest) (*pb.SearchCatalogResponse, error) {
+	query := prefixQuery(req.GetQ())
+	if query == "" {
+		return nil, status.Error(codes.InvalidArgument, "q must contain at least one word")
+	}
+
+	pageSize := int(req.GetPageSize())
+	if pageSize <= 0 {
+		pageSize = defaultPageSize
+	}
+	if pageSize > maxPageSize {
+		pageSize = maxPageSize
+	}
+
+	offset := 0
+	if token := req.GetPageToken(); token != "" {
+		var t searchPageToken
+		data, err := base64.RawURLEncoding.DecodeString(token)
+		if err == nil {
+			err = json.Unmarshal(data, &t)
+		}
+		if err != nil || t.Offset < 0 {
+			return nil, status.Error(codes.InvalidArgument, "invalid page_token")
+		}
+		if t.Query != query {
+			return nil, status.Error(codes.InvalidArgument, "page_token was issued for a different query")
+		}
+		offset = t.Offset
+	}
+
+	withComics, err := s.comicsSearchable(ctx)
+	if err != nil {
+		log.Printf("Failed to check the comics table: %v", err)
+		return nil, err
+	}
+
+	// Execute the SQL statement, fetching one extra row to know whether
+	// another page follows
+	rows, err := s.db.Query(ctx, searchSQL(withComics), query, pageSize+1, offset)
+	if err != nil {
+		log.Printf("Failed to search catalog: %v", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	var results []*pb.CatalogItem
+	for rows.Next() {
+		var (
+			kind      string
+			id        int64
+			title     string
+			author    string
+			year      int32
+			language  string
+			genres    []string
+			price     int32
+			quantity  int32
+			publisher string
+			version   int32
+			isbn10    string
+			isbn13    string
+			item      = &pb.CatalogItem{}
+		)
+		err := rows.Scan(&kind, &id, &title, &author, &year, &language, &genres, &price, &quantity, &publisher, &version, &isbn10, &isbn13,
+			&item.Score, &item.Snippet)
+		if err != nil {
+			log.Printf("Failed to scan search result: %v", err)
+			return nil, err
+		}
+		if kind == "comic" {
+			item.Item = &pb.CatalogItem_Comic{Comic: &pb.Comic{
+				Id:        id,
+				Title:     title,
+				Author:    author,
+				Year:      year,
+				Language:  language,
+				Price:     price,
+				Quantity:  quantity,
+				Publisher: publisher,
+			}}
+		} else {
+			item.Item = &pb.CatalogItem_Book{Book: &pb.Book{
+				Id:       id,
+				Title:    title,
+				Author:   author,
+				Year:     year,
+				Language: language,
+				Genres:   genres,
+				Price:    price,
+				Quantity: quantity,
+				Version:  version,
+				Isbn10:   isbn10,
+				Isbn13:   isbn13,
+			}}
+		}
+		results = append(results, item)
+	}
+	if err := rows.Err(); err != nil {
+		log.Printf("Failed to search catalog: %v", err)
+		return nil, err
+	}
+
+	response := &pb.SearchCatalogResponse{}
+	if len(results) > pageSize {
+		results = results[:pageSize]
+		data, err := json.Marshal(searchPageToken{Query: query, Offset: offset + pageSize})
+		if err != nil {
+			return nil, err
+		}
+		response.NextPageToken = base64.RawURLEncoding.EncodeToString(data)
+	}
+	response.Results = results
+	return response, nil
+}
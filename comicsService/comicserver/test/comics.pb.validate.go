@@ -0,0 +1,1276 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: comics.proto
+
+package test
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on Comic with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Comic) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Comic with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in ComicMultiError, or nil if none found.
+func (m *Comic) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Comic) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if l := utf8.RuneCountInString(m.GetTitle()); l < 1 || l > 256 {
+		err := ComicValidationError{
+			field:  "Title",
+			reason: "value length must be between 1 and 256 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetAuthor()); l < 1 || l > 256 {
+		err := ComicValidationError{
+			field:  "Author",
+			reason: "value length must be between 1 and 256 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetYear(); val <= 0 || val > 9999 {
+		err := ComicValidationError{
+			field:  "Year",
+			reason: "value must be inside range (0, 9999]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_Comic_Language_Pattern.MatchString(m.GetLanguage()) {
+		err := ComicValidationError{
+			field:  "Language",
+			reason: "value does not match regex pattern \"^[A-Za-z]{2,3}(-[A-Za-z]{4})?(-([A-Za-z]{2}|[0-9]{3}))?$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetPrice() < 0 {
+		err := ComicValidationError{
+			field:  "Price",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetQuantity() < 0 {
+		err := ComicValidationError{
+			field:  "Quantity",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetPublisher()) > 256 {
+		err := ComicValidationError{
+			field:  "Publisher",
+			reason: "value length must be at most 256 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetVersion() < 0 {
+		err := ComicValidationError{
+			field:  "Version",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ComicMultiError(errors)
+	}
+
+	return nil
+}
+
+// ComicMultiError is an error wrapping multiple validation errors returned by
+// Comic.ValidateAll() if the designated constraints aren't met.
+type ComicMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ComicMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ComicMultiError) AllErrors() []error { return m }
+
+// ComicValidationError is the validation error returned by Comic.Validate if
+// the designated constraints aren't met.
+type ComicValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ComicValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ComicValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ComicValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ComicValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ComicValidationError) ErrorName() string { return "ComicValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ComicValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sComic.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ComicValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ComicValidationError{}
+
+var _Comic_Language_Pattern = regexp.MustCompile("^[A-Za-z]{2,3}(-[A-Za-z]{4})?(-([A-Za-z]{2}|[0-9]{3}))?$")
+
+// Validate checks the field values on CreateComicRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateComicRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateComicRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateComicRequestMultiError, or nil if none found.
+func (m *CreateComicRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateComicRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetComic() == nil {
+		err := CreateComicRequestValidationError{
+			field:  "Comic",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetComic()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateComicRequestValidationError{
+					field:  "Comic",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateComicRequestValidationError{
+					field:  "Comic",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetComic()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateComicRequestValidationError{
+				field:  "Comic",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateComicRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateComicRequestMultiError is an error wrapping multiple validation errors
+// returned by CreateComicRequest.ValidateAll() if the designated constraints
+// aren't met.
+type CreateComicRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateComicRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateComicRequestMultiError) AllErrors() []error { return m }
+
+// CreateComicRequestValidationError is the validation error returned by
+// CreateComicRequest.Validate if the designated constraints aren't met.
+type CreateComicRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateComicRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateComicRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateComicRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateComicRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateComicRequestValidationError) ErrorName() string {
+	return "CreateComicRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateComicRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateComicRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateComicRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateComicRequestValidationError{}
+
+// Validate checks the field values on ReadComicRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ReadComicRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReadComicRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReadComicRequestMultiError, or nil if none found.
+func (m *ReadComicRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReadComicRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() <= 0 {
+		err := ReadComicRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ReadComicRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReadComicRequestMultiError is an error wrapping multiple validation errors
+// returned by ReadComicRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ReadComicRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReadComicRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReadComicRequestMultiError) AllErrors() []error { return m }
+
+// ReadComicRequestValidationError is the validation error returned by
+// ReadComicRequest.Validate if the designated constraints aren't met.
+type ReadComicRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReadComicRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReadComicRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReadComicRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReadComicRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReadComicRequestValidationError) ErrorName() string { return "ReadComicRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ReadComicRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReadComicRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReadComicRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReadComicRequestValidationError{}
+
+// Validate checks the field values on UpdateComicRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateComicRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateComicRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateComicRequestMultiError, or nil if none found.
+func (m *UpdateComicRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateComicRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() <= 0 {
+		err := UpdateComicRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetComic() == nil {
+		err := UpdateComicRequestValidationError{
+			field:  "Comic",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetComic()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateComicRequestValidationError{
+					field:  "Comic",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateComicRequestValidationError{
+					field:  "Comic",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetComic()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateComicRequestValidationError{
+				field:  "Comic",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdateMask()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateComicRequestValidationError{
+					field:  "UpdateMask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateComicRequestValidationError{
+					field:  "UpdateMask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdateMask()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateComicRequestValidationError{
+				field:  "UpdateMask",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateComicRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateComicRequestMultiError is an error wrapping multiple validation errors
+// returned by UpdateComicRequest.ValidateAll() if the designated constraints
+// aren't met.
+type UpdateComicRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateComicRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateComicRequestMultiError) AllErrors() []error { return m }
+
+// UpdateComicRequestValidationError is the validation error returned by
+// UpdateComicRequest.Validate if the designated constraints aren't met.
+type UpdateComicRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateComicRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateComicRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateComicRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateComicRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateComicRequestValidationError) ErrorName() string {
+	return "UpdateComicRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateComicRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateComicRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateComicRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateComicRequestValidationError{}
+
+// Validate checks the field values on DeleteComicRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteComicRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteComicRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteComicRequestMultiError, or nil if none found.
+func (m *DeleteComicRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteComicRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() <= 0 {
+		err := DeleteComicRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DeleteComicRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteComicRequestMultiError is an error wrapping multiple validation errors
+// returned by DeleteComicRequest.ValidateAll() if the designated constraints
+// aren't met.
+type DeleteComicRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteComicRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteComicRequestMultiError) AllErrors() []error { return m }
+
+// DeleteComicRequestValidationError is the validation error returned by
+// DeleteComicRequest.Validate if the designated constraints aren't met.
+type DeleteComicRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteComicRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteComicRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteComicRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteComicRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteComicRequestValidationError) ErrorName() string {
+	return "DeleteComicRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteComicRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteComicRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteComicRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteComicRequestValidationError{}
+
+// Validate checks the field values on DeleteComicResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteComicResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteComicResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteComicResponseMultiError, or nil if none found.
+func (m *DeleteComicResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteComicResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Success
+
+	if len(errors) > 0 {
+		return DeleteComicResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteComicResponseMultiError is an error wrapping multiple validation
+// errors returned by DeleteComicResponse.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteComicResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteComicResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteComicResponseMultiError) AllErrors() []error { return m }
+
+// DeleteComicResponseValidationError is the validation error returned by
+// DeleteComicResponse.Validate if the designated constraints aren't met.
+type DeleteComicResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteComicResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteComicResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteComicResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteComicResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteComicResponseValidationError) ErrorName() string {
+	return "DeleteComicResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteComicResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteComicResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteComicResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteComicResponseValidationError{}
+
+// Validate checks the field values on UndeleteComicRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UndeleteComicRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UndeleteComicRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UndeleteComicRequestMultiError, or nil if none found.
+func (m *UndeleteComicRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UndeleteComicRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() <= 0 {
+		err := UndeleteComicRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return UndeleteComicRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UndeleteComicRequestMultiError is an error wrapping multiple validation
+// errors returned by UndeleteComicRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UndeleteComicRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UndeleteComicRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UndeleteComicRequestMultiError) AllErrors() []error { return m }
+
+// UndeleteComicRequestValidationError is the validation error returned by
+// UndeleteComicRequest.Validate if the designated constraints aren't met.
+type UndeleteComicRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UndeleteComicRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UndeleteComicRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UndeleteComicRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UndeleteComicRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UndeleteComicRequestValidationError) ErrorName() string {
+	return "UndeleteComicRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UndeleteComicRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUndeleteComicRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UndeleteComicRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UndeleteComicRequestValidationError{}
+
+// Validate checks the field values on ListComicsRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListComicsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListComicsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListComicsRequestMultiError, or nil if none found.
+func (m *ListComicsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListComicsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Publisher
+
+	// no validation rules for Author
+
+	// no validation rules for Language
+
+	if m.GetMinYear() < 0 {
+		err := ListComicsRequestValidationError{
+			field:  "MinYear",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetMaxYear() < 0 {
+		err := ListComicsRequestValidationError{
+			field:  "MaxYear",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for OrderBy
+
+	if m.GetPageSize() < 0 {
+		err := ListComicsRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for PageToken
+
+	if len(errors) > 0 {
+		return ListComicsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListComicsRequestMultiError is an error wrapping multiple validation errors
+// returned by ListComicsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListComicsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListComicsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListComicsRequestMultiError) AllErrors() []error { return m }
+
+// ListComicsRequestValidationError is the validation error returned by
+// ListComicsRequest.Validate if the designated constraints aren't met.
+type ListComicsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListComicsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListComicsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListComicsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListComicsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListComicsRequestValidationError) ErrorName() string {
+	return "ListComicsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListComicsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListComicsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListComicsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListComicsRequestValidationError{}
+
+// Validate checks the field values on ListComicsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListComicsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListComicsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListComicsResponseMultiError, or nil if none found.
+func (m *ListComicsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListComicsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetComics() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListComicsResponseValidationError{
+						field:  fmt.Sprintf("Comics[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListComicsResponseValidationError{
+						field:  fmt.Sprintf("Comics[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListComicsResponseValidationError{
+					field:  fmt.Sprintf("Comics[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	// no validation rules for TotalCount
+
+	if len(errors) > 0 {
+		return ListComicsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListComicsResponseMultiError is an error wrapping multiple validation errors
+// returned by ListComicsResponse.ValidateAll() if the designated constraints
+// aren't met.
+type ListComicsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListComicsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListComicsResponseMultiError) AllErrors() []error { return m }
+
+// ListComicsResponseValidationError is the validation error returned by
+// ListComicsResponse.Validate if the designated constraints aren't met.
+type ListComicsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListComicsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListComicsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListComicsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListComicsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListComicsResponseValidationError) ErrorName() string {
+	return "ListComicsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListComicsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListComicsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListComicsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListComicsResponseValidationError{}
@@ -19,10 +19,12 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	ComicsService_CreateComic_FullMethodName = "/comics.ComicsService/CreateComic"
-	ComicsService_ReadComic_FullMethodName   = "/comics.ComicsService/ReadComic"
-	ComicsService_UpdateComic_FullMethodName = "/comics.ComicsService/UpdateComic"
-	ComicsService_DeleteComic_FullMethodName = "/comics.ComicsService/DeleteComic"
+	ComicsService_CreateComic_FullMethodName   = "/comics.ComicsService/CreateComic"
+	ComicsService_ReadComic_FullMethodName     = "/comics.ComicsService/ReadComic"
+	ComicsService_UpdateComic_FullMethodName   = "/comics.ComicsService/UpdateComic"
+	ComicsService_DeleteComic_FullMethodName   = "/comics.ComicsService/DeleteComic"
+	ComicsService_UndeleteComic_FullMethodName = "/comics.ComicsService/UndeleteComic"
+	ComicsService_ListComics_FullMethodName    = "/comics.ComicsService/ListComics"
 )
 
 // ComicsServiceClient is the client API for ComicsService service.
@@ -33,6 +35,9 @@ type ComicsServiceClient interface {
 	ReadComic(ctx context.Context, in *ReadComicRequest, opts ...grpc.CallOption) (*Comic, error)
 	UpdateComic(ctx context.Context, in *UpdateComicRequest, opts ...grpc.CallOption) (*Comic, error)
 	DeleteComic(ctx context.Context, in *DeleteComicRequest, opts ...grpc.CallOption) (*DeleteComicResponse, error)
+	// UndeleteComic restores a deleted comic that has not been purged yet.
+	UndeleteComic(ctx context.Context, in *UndeleteComicRequest, opts ...grpc.CallOption) (*Comic, error)
+	ListComics(ctx context.Context, in *ListComicsRequest, opts ...grpc.CallOption) (*ListComicsResponse, error)
 }
 
 type comicsServiceClient struct {
@@ -79,6 +84,24 @@ func (c *comicsServiceClient) DeleteComic(ctx context.Context, in *DeleteComicRe
 	return out, nil
 }
 
+func (c *comicsServiceClient) UndeleteComic(ctx context.Context, in *UndeleteComicRequest, opts ...grpc.CallOption) (*Comic, error) {
+	out := new(Comic)
+	err := c.cc.Invoke(ctx, ComicsService_UndeleteComic_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *comicsServiceClient) ListComics(ctx context.Context, in *ListComicsRequest, opts ...grpc.CallOption) (*ListComicsResponse, error) {
+	out := new(ListComicsResponse)
+	err := c.cc.Invoke(ctx, ComicsService_ListComics_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ComicsServiceServer is the server API for ComicsService service.
 // All implementations must embed UnimplementedComicsServiceServer
 // for forward compatibility
@@ -87,6 +110,9 @@ type ComicsServiceServer interface {
 	ReadComic(context.Context, *ReadComicRequest) (*Comic, error)
 	UpdateComic(context.Context, *UpdateComicRequest) (*Comic, error)
 	DeleteComic(context.Context, *DeleteComicRequest) (*DeleteComicResponse, error)
+	// UndeleteComic restores a deleted comic that has not been purged yet.
+	UndeleteComic(context.Context, *UndeleteComicRequest) (*Comic, error)
+	ListComics(context.Context, *ListComicsRequest) (*ListComicsResponse, error)
 	mustEmbedUnimplementedComicsServiceServer()
 }
 
@@ -106,6 +132,12 @@ func (UnimplementedComicsServiceServer) UpdateComic(context.Context, *UpdateComi
 func (UnimplementedComicsServiceServer) DeleteComic(context.Context, *DeleteComicRequest) (*DeleteComicResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteComic not implemented")
 }
+func (UnimplementedComicsServiceServer) UndeleteComic(context.Context, *UndeleteComicRequest) (*Comic, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UndeleteComic not implemented")
+}
+func (UnimplementedComicsServiceServer) ListComics(context.Context, *ListComicsRequest) (*ListComicsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListComics not implemented")
+}
 func (UnimplementedComicsServiceServer) mustEmbedUnimplementedComicsServiceServer() {}
 
 // UnsafeComicsServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -191,6 +223,42 @@ func _ComicsService_DeleteComic_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ComicsService_UndeleteComic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UndeleteComicRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ComicsServiceServer).UndeleteComic(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ComicsService_UndeleteComic_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ComicsServiceServer).UndeleteComic(ctx, req.(*UndeleteComicRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ComicsService_ListComics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListComicsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ComicsServiceServer).ListComics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ComicsService_ListComics_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ComicsServiceServer).ListComics(ctx, req.(*ListComicsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ComicsService_ServiceDesc is the grpc.ServiceDesc for ComicsService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -214,6 +282,14 @@ var ComicsService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteComic",
 			Handler:    _ComicsService_DeleteComic_Handler,
 		},
+		{
+			MethodName: "UndeleteComic",
+			Handler:    _ComicsService_UndeleteComic_Handler,
+		},
+		{
+			MethodName: "ListComics",
+			Handler:    _ComicsService_ListComics_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "comics.proto",
@@ -0,0 +1,27 @@
+package main
+
+import "common/broker"
+
+const (
+	// comicEventsExchange is the topic exchange comic events are published
+	// to, with the event type as routing key.
+	comicEventsExchange = "comic_events"
+	comicEventsQueue    = "comic_events_queue"
+
+	comicCreated  = "comic.created"
+	comicUpdated  = "comic.updated"
+	comicDeleted  = "comic.deleted"
+	comicRestored = "comic.restored"
+)
+
+var comicTopology = broker.Topology{
+	Exchanges: []broker.Exchange{
+		{Name: comicEventsExchange, Kind: broker.ExchangeTopic},
+	},
+	Queues: []broker.Queue{
+		{
+			Name:     comicEventsQueue,
+			Bindings: []broker.Binding{{Exchange: comicEventsExchange, Key: "comic.#"}},
+		},
+	},
+}
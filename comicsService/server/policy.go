@@ -0,0 +1,21 @@
+package main
+
+import (
+	pb "comicService/comicserver/test"
+	"common/auth"
+)
+
+// catalogEditors may change the catalog.
+var catalogEditors = auth.AnyPermission(auth.PermissionCatalogWrite)
+
+// policy says who may call each method. Reading the catalog is open to
+// everyone; changing it takes an editor.
+var policy = auth.Policy{
+	pb.ComicsService_ReadComic_FullMethodName:  auth.Public,
+	pb.ComicsService_ListComics_FullMethodName: auth.Public,
+
+	pb.ComicsService_CreateComic_FullMethodName:   catalogEditors,
+	pb.ComicsService_UpdateComic_FullMethodName:   catalogEditors,
+	pb.ComicsService_DeleteComic_FullMethodName:   catalogEditors,
+	pb.ComicsService_UndeleteComic_FullMethodName: catalogEditors,
+}
@@ -0,0 +1,41 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"log"
+	"time"
+)
+
+const (
+	// deletedComicRetention is how long a deleted comic can be restored
+	// before it is removed for good.
+	deletedComicRetention = 30 * 24 * time.Hour
+
+	purgeInterval = time.Hour
+)
+
+// purgeDeletedComics hard-deletes comics deleted longer ago than the
+// retention window, once now and then every purgeInterval until ctx is done.
+func purgeDeletedComics(ctx context.Context, db *sql.DB) {
+	ticker := time.NewTicker(purgeInterval)
+	defer ticker.Stop()
+
+	for {
+		result, err := db.ExecContext(ctx, `
+			DELETE FROM comics
+			WHERE deleted_at < now() - $1 * interval '1 second'
+		`, deletedComicRetention.Seconds())
+		if err != nil {
+			log.Printf("Failed to purge deleted comics: %v", err)
+		} else if n, err := result.RowsAffected(); err == nil && n > 0 {
+			log.Printf("Purged %d deleted comics", n)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,37 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+)
+
+// schema holds the statements that bring an existing bookstore database up
+// to date with this server. Every statement must be safe to run on each
+// start, so they only ever create what is missing.
+var schema = []string{
+	`ALTER TABLE comics ADD COLUMN IF NOT EXISTS version INTEGER NOT NULL DEFAULT 1`,
+	`ALTER TABLE comics ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMPTZ`,
+	`ALTER TABLE comics ADD COLUMN IF NOT EXISTS deleted_by TEXT`,
+	`CREATE INDEX IF NOT EXISTS comics_deleted_at_idx
+		ON comics (deleted_at)
+		WHERE deleted_at IS NOT NULL`,
+	// The book server's SearchCatalog matches comics against search_vector:
+	// title words weigh most, then author, then publisher
+	`ALTER TABLE comics ADD COLUMN IF NOT EXISTS search_vector tsvector
+		GENERATED ALWAYS AS (
+			setweight(to_tsvector('simple', coalesce(title, '')), 'A') ||
+			setweight(to_tsvector('simple', coalesce(author, '')), 'B') ||
+			setweight(to_tsvector('simple', coalesce(publisher, '')), 'C')
+		) STORED`,
+	`CREATE INDEX IF NOT EXISTS comics_search_vector_idx ON comics USING GIN (search_vector)`,
+}
+
+func migrate(ctx context.Context, db *sql.DB) error {
+	for _, stmt := range schema {
+		if _, err := db.ExecContext(ctx, stmt); err != nil {
+			return fmt.Errorf("apply schema: %w", err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,168 @@
+package auth
+
+import (
+	"context"
+	"log"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// Roles the user service starts out with. Admins hold every permission.
+const (
+	RoleAdmin         = "admin"
+	RoleCatalogEditor = "catalog_editor"
+)
+
+// Permissions the services check.
+const (
+	PermissionCatalogWrite = "catalog.write"
+	PermissionUsersRead    = "users.read"
+	PermissionUsersWrite   = "users.write"
+	PermissionRolesManage  = "roles.manage"
+)
+
+// Rule says who may call a method.
+type Rule struct {
+	// Public methods may be called without a token.
+	Public bool
+
+	// Roles and Permissions list what allows calling the method; holding
+	// any one of them is enough. A method that is neither public nor lists
+	// any only needs a valid token.
+	Roles       []string
+	Permissions []string
+}
+
+// Public is the rule of methods anyone may call.
+var Public = Rule{Public: true}
+
+// Authenticated is the rule of methods any signed-in user may call.
+var Authenticated = Rule{}
+
+// AnyRole returns the rule of methods callers holding one of roles may call.
+func AnyRole(roles ...string) Rule {
+	return Rule{Roles: roles}
+}
+
+// AnyPermission returns the rule of methods callers holding one of
+// permissions may call.
+func AnyPermission(permissions ...string) Rule {
+	return Rule{Permissions: permissions}
+}
+
+// allows reports whether claims satisfy a non-public rule.
+func (r Rule) allows(claims *Claims) bool {
+	if len(r.Roles) == 0 && len(r.Permissions) == 0 {
+		return true
+	}
+	for _, role := range r.Roles {
+		if claims.HasRole(role) {
+			return true
+		}
+	}
+	for _, permission := range r.Permissions {
+		if claims.HasPermission(permission) {
+			return true
+		}
+	}
+	return false
+}
+
+// Policy maps full gRPC method names, such as "/booking.BookingService/CreateBook",
+// to the rule for calling them. Methods missing from the policy are denied,
+// so new methods stay closed until someone decides who may call them.
+type Policy map[string]Rule
+
+// authorizationKey is the metadata key carrying the bearer token. The gateway
+// forwards the HTTP Authorization header under this key.
+const authorizationKey = "authorization"
+
+type claimsKey struct{}
+
+// NewContext returns a context carrying the claims of the caller's token.
+func NewContext(ctx context.Context, claims *Claims) context.Context {
+	return context.WithValue(ctx, claimsKey{}, claims)
+}
+
+// FromContext returns the claims the interceptor verified for the request,
+// if it carried a token.
+func FromContext(ctx context.Context) (*Claims, bool) {
+	claims, ok := ctx.Value(claimsKey{}).(*Claims)
+	return claims, ok
+}
+
+// UnaryServerInterceptor enforces policy on every call. The claims of a valid
+// token are available to handlers through FromContext. Requests without a token
+// fail with UNAUTHENTICATED unless the method is public, and requests lacking a
+// required role or permission fail with PERMISSION_DENIED. A token that is sent
+// must be valid and not revoked, even to public methods.
+func (v *Verifier) UnaryServerInterceptor(policy Policy) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		rule, ok := policy[info.FullMethod]
+		if !ok {
+			return nil, status.Errorf(codes.PermissionDenied, "%s is not allowed", info.FullMethod)
+		}
+
+		token, err := bearerToken(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if token == "" {
+			if rule.Public {
+				return handler(ctx, req)
+			}
+			return nil, status.Error(codes.Unauthenticated, "missing bearer token")
+		}
+
+		claims, err := v.Verify(token)
+		if err != nil {
+			return nil, status.Error(codes.Unauthenticated, "invalid or expired token")
+		}
+		if err := v.checkRevoked(ctx, claims); err != nil {
+			return nil, err
+		}
+		if !rule.Public && !rule.allows(claims) {
+			required := append(append([]string(nil), rule.Roles...), rule.Permissions...)
+			return nil, status.Errorf(codes.PermissionDenied, "requires one of %s", strings.Join(required, ", "))
+		}
+		return handler(NewContext(ctx, claims), req)
+	}
+}
+
+// checkRevoked fails for tokens whose session has been logged out.
+func (v *Verifier) checkRevoked(ctx context.Context, claims *Claims) error {
+	if v.revocations == nil {
+		return nil
+	}
+	if claims.SessionID == "" {
+		return status.Error(codes.Unauthenticated, "invalid or expired token")
+	}
+	revoked, err := v.revocations.Revoked(ctx, claims.SessionID)
+	if err != nil {
+		log.Printf("Failed to check revocation of session %s: %v", claims.SessionID, err)
+		return status.Error(codes.Unavailable, "cannot check token revocation")
+	}
+	if revoked {
+		return status.Error(codes.Unauthenticated, "token has been revoked")
+	}
+	return nil
+}
+
+// bearerToken returns the token of the request's Authorization metadata, or
+// "" if there is none.
+func bearerToken(ctx context.Context) (string, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	values := md.Get(authorizationKey)
+	if len(values) == 0 || values[0] == "" {
+		return "", nil
+	}
+	scheme, token, ok := strings.Cut(values[0], " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") || strings.TrimSpace(token) == "" {
+		return "", status.Error(codes.Unauthenticated, `authorization must be "Bearer <token>"`)
+	}
+	return strings.TrimSpace(token), nil
+}
@@ -0,0 +1,151 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+var testPolicy = Policy{
+	"/test.Service/Public":        Public,
+	"/test.Service/Authenticated": Authenticated,
+	"/test.Service/Admin":         AnyRole(RoleAdmin),
+	"/test.Service/Write":         AnyPermission(PermissionCatalogWrite),
+}
+
+// testRevocations revokes the session named "revoked" and cannot look up
+// the session named "unreachable".
+var testRevocations = RevocationFunc(func(ctx context.Context, session string) (bool, error) {
+	switch session {
+	case "revoked":
+		return true, nil
+	case "unreachable":
+		return false, errors.New("database is down")
+	default:
+		return false, nil
+	}
+})
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	issuer, err := NewIssuer(testConfig())
+	if err != nil {
+		t.Fatalf("NewIssuer: %v", err)
+	}
+	token := func(session string, roles, permissions []string) string {
+		token, _, err := issuer.Issue(42, session, roles, permissions)
+		if err != nil {
+			t.Fatalf("Issue: %v", err)
+		}
+		return "Bearer " + token
+	}
+	expired := testClaims()
+	expired.ExpiresAt = jwt.NewNumericDate(time.Now().Add(-time.Minute))
+	expiredToken := "Bearer " + sign(t, jwt.SigningMethodHS256, testSecret, expired)
+
+	user := token("session", nil, nil)
+	admin := token("session", []string{RoleAdmin}, nil)
+	editor := token("session", nil, []string{PermissionCatalogWrite})
+
+	tests := []struct {
+		name          string
+		method        string
+		authorization string
+		want          codes.Code
+	}{
+		{"unknown method", "/test.Service/Unknown", admin, codes.PermissionDenied},
+		{"unknown method without token", "/test.Service/Unknown", "", codes.PermissionDenied},
+		{"public without token", "/test.Service/Public", "", codes.OK},
+		{"public with token", "/test.Service/Public", user, codes.OK},
+		{"public with expired token", "/test.Service/Public", expiredToken, codes.Unauthenticated},
+		{"public with garbage token", "/test.Service/Public", "Bearer garbage", codes.Unauthenticated},
+		{"public with revoked token", "/test.Service/Public", token("revoked", nil, nil), codes.Unauthenticated},
+		{"authenticated without token", "/test.Service/Authenticated", "", codes.Unauthenticated},
+		{"authenticated with token", "/test.Service/Authenticated", user, codes.OK},
+		{"authenticated with expired token", "/test.Service/Authenticated", expiredToken, codes.Unauthenticated},
+		{"not a bearer token", "/test.Service/Authenticated", "Basic dXNlcjpwYXNz", codes.Unauthenticated},
+		{"lowercase scheme", "/test.Service/Authenticated", "bearer" + user[len("Bearer"):], codes.OK},
+		{"revoked session", "/test.Service/Authenticated", token("revoked", nil, nil), codes.Unauthenticated},
+		{"no session", "/test.Service/Authenticated", token("", nil, nil), codes.Unauthenticated},
+		{"revocation lookup fails", "/test.Service/Authenticated", token("unreachable", nil, nil), codes.Unavailable},
+		{"role required", "/test.Service/Admin", user, codes.PermissionDenied},
+		{"role held", "/test.Service/Admin", admin, codes.OK},
+		{"permission instead of role", "/test.Service/Admin", editor, codes.PermissionDenied},
+		{"permission required", "/test.Service/Write", admin, codes.PermissionDenied},
+		{"permission held", "/test.Service/Write", editor, codes.OK},
+	}
+
+	interceptor := newTestVerifier(t, testConfig()).WithRevocations(testRevocations).UnaryServerInterceptor(testPolicy)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.authorization != "" {
+				ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(authorizationKey, tt.authorization))
+			}
+			var sawClaims bool
+			handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+				_, sawClaims = FromContext(ctx)
+				return "ok", nil
+			}
+			_, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: tt.method}, handler)
+			if got := status.Code(err); got != tt.want {
+				t.Fatalf("code = %v (%v), want %v", got, err, tt.want)
+			}
+			if err == nil && sawClaims != (tt.authorization != "") {
+				t.Errorf("handler saw claims = %v, want %v", sawClaims, tt.authorization != "")
+			}
+		})
+	}
+}
+
+func TestInterceptorWithoutRevocations(t *testing.T) {
+	issuer, err := NewIssuer(testConfig())
+	if err != nil {
+		t.Fatalf("NewIssuer: %v", err)
+	}
+	// Tokens without a session are fine when nothing is revoked
+	token, _, err := issuer.Issue(42, "", nil, nil)
+	if err != nil {
+		t.Fatalf("Issue: %v", err)
+	}
+	interceptor := newTestVerifier(t, testConfig()).UnaryServerInterceptor(testPolicy)
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(authorizationKey, "Bearer "+token))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) { return nil, nil }
+	_, err = interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: "/test.Service/Authenticated"}, handler)
+	if err != nil {
+		t.Errorf("interceptor error = %v, want nil", err)
+	}
+}
+
+func TestCacheRevocations(t *testing.T) {
+	calls := 0
+	revoked := false
+	cache := CacheRevocations(RevocationFunc(func(ctx context.Context, session string) (bool, error) {
+		calls++
+		return revoked, nil
+	}), time.Hour)
+
+	for i := 0; i < 3; i++ {
+		if got, err := cache.Revoked(context.Background(), "session"); got || err != nil {
+			t.Fatalf("Revoked = %v, %v; want false", got, err)
+		}
+	}
+	if calls != 1 {
+		t.Errorf("looked up %d times, want 1", calls)
+	}
+
+	// Answers are kept for the TTL, even once the session is revoked
+	revoked = true
+	if got, _ := cache.Revoked(context.Background(), "session"); got {
+		t.Error("Revoked = true within the TTL of a cached answer")
+	}
+	if got, _ := cache.Revoked(context.Background(), "other"); !got {
+		t.Error("Revoked = false for an uncached revoked session")
+	}
+}
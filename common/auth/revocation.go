@@ -0,0 +1,108 @@
+package auth
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+// Revocations reports whether sessions have been revoked by logging out.
+type Revocations interface {
+	Revoked(ctx context.Context, session string) (bool, error)
+}
+
+// RevocationFunc adapts a function to Revocations.
+type RevocationFunc func(ctx context.Context, session string) (bool, error)
+
+// Revoked implements Revocations.
+func (f RevocationFunc) Revoked(ctx context.Context, session string) (bool, error) {
+	return f(ctx, session)
+}
+
+// RevocationCacheTTL is how long services other than the user service
+// cache revocations, and so how long a token keeps working after its
+// session is logged out.
+const RevocationCacheTTL = 30 * time.Second
+
+// revokedSessionQuery selects whether the session $1 is revoked from the
+// sessions table of the user service. It returns no rows for unknown
+// sessions, which count as revoked.
+const revokedSessionQuery = `SELECT revoked_at IS NOT NULL FROM user_sessions WHERE id = $1`
+
+// PoolRevocations looks sessions up in the user service's table through a
+// pgx pool on the database it shares with the user service.
+func PoolRevocations(db *pgxpool.Pool) Revocations {
+	return RevocationFunc(func(ctx context.Context, session string) (bool, error) {
+		var revoked bool
+		err := db.QueryRow(ctx, revokedSessionQuery, session).Scan(&revoked)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return true, nil
+		}
+		return revoked, err
+	})
+}
+
+// DBRevocations is PoolRevocations for database/sql.
+func DBRevocations(db *sql.DB) Revocations {
+	return RevocationFunc(func(ctx context.Context, session string) (bool, error) {
+		var revoked bool
+		err := db.QueryRowContext(ctx, revokedSessionQuery, session).Scan(&revoked)
+		if errors.Is(err, sql.ErrNoRows) {
+			return true, nil
+		}
+		return revoked, err
+	})
+}
+
+// CacheRevocations remembers the answers of r for ttl, so that not every
+// request costs a lookup. A revoked token keeps working for at most ttl.
+func CacheRevocations(r Revocations, ttl time.Duration) Revocations {
+	return &revocationCache{next: r, ttl: ttl, entries: make(map[string]revocationEntry)}
+}
+
+type revocationCache struct {
+	next Revocations
+	ttl  time.Duration
+
+	mu      sync.Mutex
+	entries map[string]revocationEntry
+}
+
+type revocationEntry struct {
+	revoked bool
+	expires time.Time
+}
+
+func (c *revocationCache) Revoked(ctx context.Context, session string) (bool, error) {
+	now := time.Now()
+	c.mu.Lock()
+	entry, ok := c.entries[session]
+	c.mu.Unlock()
+	if ok && now.Before(entry.expires) {
+		return entry.revoked, nil
+	}
+
+	revoked, err := c.next.Revoked(ctx, session)
+	if err != nil {
+		return false, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	// Drop stale entries now and then, so the map does not keep every
+	// session ever seen
+	if len(c.entries) >= 10000 {
+		for key, e := range c.entries {
+			if !now.Before(e.expires) {
+				delete(c.entries, key)
+			}
+		}
+	}
+	c.entries[session] = revocationEntry{revoked: revoked, expires: now.Add(c.ttl)}
+	return revoked, nil
+}
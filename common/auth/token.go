@@ -0,0 +1,273 @@
+// Package auth issues and verifies the JSON Web Tokens the user service hands
+// out on login and the other services accept.
+package auth
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// Signing algorithms a token can use.
+const (
+	HS256 = "HS256"
+	RS256 = "RS256"
+	EdDSA = "EdDSA"
+)
+
+// ErrInvalidToken is returned for tokens that are malformed, badly signed,
+// expired or meant for someone else.
+var ErrInvalidToken = errors.New("auth: invalid token")
+
+// Claims are the claims of an access token. The subject is the user id.
+type Claims struct {
+	jwt.RegisteredClaims
+	// SessionID identifies the login the token was issued for, so that
+	// logging out can revoke the token before it expires.
+	SessionID string `json:"sid,omitempty"`
+	// Roles are the roles of the user, and Permissions everything those
+	// roles allow, so that services can authorize without asking the user
+	// service.
+	Roles       []string `json:"roles,omitempty"`
+	Permissions []string `json:"perms,omitempty"`
+}
+
+// UserID returns the id of the user the token was issued to.
+func (c *Claims) UserID() (int32, error) {
+	id, err := strconv.ParseInt(c.Subject, 10, 32)
+	if err != nil {
+		return 0, fmt.Errorf("%w: subject %q is not a user id", ErrInvalidToken, c.Subject)
+	}
+	return int32(id), nil
+}
+
+// HasRole reports whether the token grants role.
+func (c *Claims) HasRole(role string) bool {
+	return contains(c.Roles, role)
+}
+
+// HasPermission reports whether the token grants permission.
+func (c *Claims) HasPermission(permission string) bool {
+	return contains(c.Permissions, permission)
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// Config describes how tokens are signed and what they are valid for.
+type Config struct {
+	Issuer   string
+	Audience string
+	TTL      time.Duration
+
+	// Algorithm is HS256, RS256 or EdDSA.
+	Algorithm string
+
+	// Secret is the HS256 key.
+	Secret []byte
+
+	// PrivateKeyFile and PublicKeyFile are PEM files holding the RS256 or
+	// EdDSA keys. Only the issuer needs the private key.
+	PrivateKeyFile string
+	PublicKeyFile  string
+}
+
+// ConfigFromEnv reads JWT_ISSUER, JWT_AUDIENCE, JWT_TTL (a Go duration),
+// JWT_ALGORITHM, JWT_SECRET, JWT_PRIVATE_KEY and JWT_PUBLIC_KEY. Tokens
+// default to HS256 with a 15 minute lifetime.
+func ConfigFromEnv() (Config, error) {
+	cfg := Config{
+		Issuer:         os.Getenv("JWT_ISSUER"),
+		Audience:       os.Getenv("JWT_AUDIENCE"),
+		TTL:            15 * time.Minute,
+		Algorithm:      os.Getenv("JWT_ALGORITHM"),
+		Secret:         []byte(os.Getenv("JWT_SECRET")),
+		PrivateKeyFile: os.Getenv("JWT_PRIVATE_KEY"),
+		PublicKeyFile:  os.Getenv("JWT_PUBLIC_KEY"),
+	}
+	if cfg.Issuer == "" {
+		cfg.Issuer = "bookstore"
+	}
+	if cfg.Audience == "" {
+		cfg.Audience = "bookstore"
+	}
+	if cfg.Algorithm == "" {
+		cfg.Algorithm = HS256
+	}
+	if v := os.Getenv("JWT_TTL"); v != "" {
+		ttl, err := time.ParseDuration(v)
+		if err != nil {
+			return cfg, fmt.Errorf("auth: invalid JWT_TTL: %w", err)
+		}
+		cfg.TTL = ttl
+	}
+	return cfg, nil
+}
+
+func (cfg Config) method() (jwt.SigningMethod, error) {
+	switch cfg.Algorithm {
+	case HS256:
+		return jwt.SigningMethodHS256, nil
+	case RS256:
+		return jwt.SigningMethodRS256, nil
+	case EdDSA:
+		return jwt.SigningMethodEdDSA, nil
+	default:
+		return nil, fmt.Errorf("auth: unknown algorithm %q", cfg.Algorithm)
+	}
+}
+
+func (cfg Config) signingKey() (interface{}, error) {
+	switch cfg.Algorithm {
+	case HS256:
+		if len(cfg.Secret) < 32 {
+			return nil, errors.New("auth: HS256 secret must be at least 32 bytes")
+		}
+		return cfg.Secret, nil
+	case RS256:
+		pem, err := os.ReadFile(cfg.PrivateKeyFile)
+		if err != nil {
+			return nil, err
+		}
+		return jwt.ParseRSAPrivateKeyFromPEM(pem)
+	case EdDSA:
+		pem, err := os.ReadFile(cfg.PrivateKeyFile)
+		if err != nil {
+			return nil, err
+		}
+		return jwt.ParseEdPrivateKeyFromPEM(pem)
+	default:
+		return nil, fmt.Errorf("auth: unknown algorithm %q", cfg.Algorithm)
+	}
+}
+
+func (cfg Config) verificationKey() (interface{}, error) {
+	switch cfg.Algorithm {
+	case HS256:
+		if len(cfg.Secret) < 32 {
+			return nil, errors.New("auth: HS256 secret must be at least 32 bytes")
+		}
+		return cfg.Secret, nil
+	case RS256:
+		pem, err := os.ReadFile(cfg.PublicKeyFile)
+		if err != nil {
+			return nil, err
+		}
+		return jwt.ParseRSAPublicKeyFromPEM(pem)
+	case EdDSA:
+		pem, err := os.ReadFile(cfg.PublicKeyFile)
+		if err != nil {
+			return nil, err
+		}
+		return jwt.ParseEdPublicKeyFromPEM(pem)
+	default:
+		return nil, fmt.Errorf("auth: unknown algorithm %q", cfg.Algorithm)
+	}
+}
+
+// Issuer signs access tokens.
+type Issuer struct {
+	cfg    Config
+	method jwt.SigningMethod
+	key    interface{}
+}
+
+// NewIssuer returns an Issuer signing tokens as cfg describes.
+func NewIssuer(cfg Config) (*Issuer, error) {
+	method, err := cfg.method()
+	if err != nil {
+		return nil, err
+	}
+	key, err := cfg.signingKey()
+	if err != nil {
+		return nil, err
+	}
+	return &Issuer{cfg: cfg, method: method, key: key}, nil
+}
+
+// Issue returns a signed token for user id with roles and permissions in
+// session, and when it expires.
+func (i *Issuer) Issue(id int32, session string, roles, permissions []string) (string, time.Time, error) {
+	now := time.Now()
+	expiresAt := now.Add(i.cfg.TTL)
+	claims := &Claims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:    i.cfg.Issuer,
+			Subject:   strconv.Itoa(int(id)),
+			Audience:  jwt.ClaimStrings{i.cfg.Audience},
+			IssuedAt:  jwt.NewNumericDate(now),
+			NotBefore: jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(expiresAt),
+		},
+		SessionID:   session,
+		Roles:       roles,
+		Permissions: permissions,
+	}
+	token, err := jwt.NewWithClaims(i.method, claims).SignedString(i.key)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	return token, expiresAt, nil
+}
+
+// Verifier checks access tokens.
+type Verifier struct {
+	parser      *jwt.Parser
+	key         interface{}
+	revocations Revocations
+}
+
+// NewVerifier returns a Verifier accepting tokens issued as cfg describes.
+func NewVerifier(cfg Config) (*Verifier, error) {
+	method, err := cfg.method()
+	if err != nil {
+		return nil, err
+	}
+	key, err := cfg.verificationKey()
+	if err != nil {
+		return nil, err
+	}
+	parser := jwt.NewParser(
+		jwt.WithValidMethods([]string{method.Alg()}),
+		jwt.WithIssuer(cfg.Issuer),
+		jwt.WithAudience(cfg.Audience),
+	)
+	return &Verifier{parser: parser, key: key}, nil
+}
+
+// WithRevocations returns a copy of v whose interceptor also rejects tokens
+// of sessions r reports as revoked, and tokens that name no session.
+func (v *Verifier) WithRevocations(r Revocations) *Verifier {
+	c := *v
+	c.revocations = r
+	return &c
+}
+
+// Verify returns the claims of a valid token, or ErrInvalidToken.
+func (v *Verifier) Verify(token string) (*Claims, error) {
+	claims := &Claims{}
+	_, err := v.parser.ParseWithClaims(token, claims, func(*jwt.Token) (interface{}, error) {
+		return v.key, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidToken, err)
+	}
+	// Tokens without an expiry would be valid forever
+	if claims.ExpiresAt == nil {
+		return nil, fmt.Errorf("%w: no expiry", ErrInvalidToken)
+	}
+	if _, err := claims.UserID(); err != nil {
+		return nil, err
+	}
+	return claims, nil
+}
@@ -0,0 +1,209 @@
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+var testSecret = []byte("0123456789abcdef0123456789abcdef")
+
+func testConfig() Config {
+	return Config{
+		Issuer:    "bookstore",
+		Audience:  "bookstore",
+		TTL:       time.Minute,
+		Algorithm: HS256,
+		Secret:    testSecret,
+	}
+}
+
+func newTestVerifier(t *testing.T, cfg Config) *Verifier {
+	t.Helper()
+	v, err := NewVerifier(cfg)
+	if err != nil {
+		t.Fatalf("NewVerifier: %v", err)
+	}
+	return v
+}
+
+// testClaims returns the claims of a valid token for user 42.
+func testClaims() *Claims {
+	now := time.Now()
+	return &Claims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:    "bookstore",
+			Subject:   "42",
+			Audience:  jwt.ClaimStrings{"bookstore"},
+			IssuedAt:  jwt.NewNumericDate(now),
+			NotBefore: jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(time.Minute)),
+		},
+		SessionID: "session",
+	}
+}
+
+func sign(t *testing.T, method jwt.SigningMethod, key interface{}, claims jwt.Claims) string {
+	t.Helper()
+	token, err := jwt.NewWithClaims(method, claims).SignedString(key)
+	if err != nil {
+		t.Fatalf("sign: %v", err)
+	}
+	return token
+}
+
+func TestIssueVerify(t *testing.T) {
+	cfg := testConfig()
+	issuer, err := NewIssuer(cfg)
+	if err != nil {
+		t.Fatalf("NewIssuer: %v", err)
+	}
+	token, expiresAt, err := issuer.Issue(42, "session", []string{RoleAdmin}, []string{PermissionUsersRead})
+	if err != nil {
+		t.Fatalf("Issue: %v", err)
+	}
+	if d := time.Until(expiresAt); d <= 0 || d > cfg.TTL {
+		t.Errorf("token expires in %v, want within %v", d, cfg.TTL)
+	}
+
+	claims, err := newTestVerifier(t, cfg).Verify(token)
+	if err != nil {
+		t.Fatalf("Verify: %v", err)
+	}
+	if id, err := claims.UserID(); id != 42 || err != nil {
+		t.Errorf("UserID = %d, %v; want 42", id, err)
+	}
+	if claims.SessionID != "session" {
+		t.Errorf("SessionID = %q, want %q", claims.SessionID, "session")
+	}
+	if !claims.HasRole(RoleAdmin) || claims.HasRole(RoleCatalogEditor) {
+		t.Errorf("Roles = %v, want only %s", claims.Roles, RoleAdmin)
+	}
+	if !claims.HasPermission(PermissionUsersRead) || claims.HasPermission(PermissionUsersWrite) {
+		t.Errorf("Permissions = %v, want only %s", claims.Permissions, PermissionUsersRead)
+	}
+}
+
+func TestVerifyRejects(t *testing.T) {
+	tests := []struct {
+		name  string
+		token func(t *testing.T) string
+	}{
+		{"garbage", func(t *testing.T) string { return "not.a.token" }},
+		{"wrong secret", func(t *testing.T) string {
+			return sign(t, jwt.SigningMethodHS256, []byte("another secret of at least 32 bytes"), testClaims())
+		}},
+		{"other algorithm", func(t *testing.T) string {
+			return sign(t, jwt.SigningMethodHS512, testSecret, testClaims())
+		}},
+		{"alg none", func(t *testing.T) string {
+			return sign(t, jwt.SigningMethodNone, jwt.UnsafeAllowNoneSignatureType, testClaims())
+		}},
+		{"wrong issuer", func(t *testing.T) string {
+			c := testClaims()
+			c.Issuer = "someone else"
+			return sign(t, jwt.SigningMethodHS256, testSecret, c)
+		}},
+		{"wrong audience", func(t *testing.T) string {
+			c := testClaims()
+			c.Audience = jwt.ClaimStrings{"another service"}
+			return sign(t, jwt.SigningMethodHS256, testSecret, c)
+		}},
+		{"expired", func(t *testing.T) string {
+			c := testClaims()
+			c.ExpiresAt = jwt.NewNumericDate(time.Now().Add(-time.Minute))
+			return sign(t, jwt.SigningMethodHS256, testSecret, c)
+		}},
+		{"not yet valid", func(t *testing.T) string {
+			c := testClaims()
+			c.NotBefore = jwt.NewNumericDate(time.Now().Add(time.Hour))
+			return sign(t, jwt.SigningMethodHS256, testSecret, c)
+		}},
+		{"no expiry", func(t *testing.T) string {
+			c := testClaims()
+			c.ExpiresAt = nil
+			return sign(t, jwt.SigningMethodHS256, testSecret, c)
+		}},
+		{"non-numeric subject", func(t *testing.T) string {
+			c := testClaims()
+			c.Subject = "admin"
+			return sign(t, jwt.SigningMethodHS256, testSecret, c)
+		}},
+		{"subject out of range", func(t *testing.T) string {
+			c := testClaims()
+			c.Subject = "4294967296"
+			return sign(t, jwt.SigningMethodHS256, testSecret, c)
+		}},
+	}
+	v := newTestVerifier(t, testConfig())
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := v.Verify(tt.token(t)); !errors.Is(err, ErrInvalidToken) {
+				t.Errorf("Verify error = %v, want ErrInvalidToken", err)
+			}
+		})
+	}
+}
+
+func TestVerifyRS256(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	publicDER, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	publicPEM := pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: publicDER})
+	dir := t.TempDir()
+	cfg := testConfig()
+	cfg.Algorithm = RS256
+	cfg.Secret = nil
+	cfg.PrivateKeyFile = filepath.Join(dir, "private.pem")
+	cfg.PublicKeyFile = filepath.Join(dir, "public.pem")
+	privatePEM := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})
+	if err := os.WriteFile(cfg.PrivateKeyFile, privatePEM, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(cfg.PublicKeyFile, publicPEM, 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	issuer, err := NewIssuer(cfg)
+	if err != nil {
+		t.Fatalf("NewIssuer: %v", err)
+	}
+	token, _, err := issuer.Issue(42, "session", nil, nil)
+	if err != nil {
+		t.Fatalf("Issue: %v", err)
+	}
+	v := newTestVerifier(t, cfg)
+	if _, err := v.Verify(token); err != nil {
+		t.Errorf("Verify: %v", err)
+	}
+
+	// An HMAC signed with the public key must not pass for RS256
+	forged := sign(t, jwt.SigningMethodHS256, publicPEM, testClaims())
+	if _, err := v.Verify(forged); !errors.Is(err, ErrInvalidToken) {
+		t.Errorf("Verify(HS256 signed with the public key) error = %v, want ErrInvalidToken", err)
+	}
+}
+
+func TestNewIssuerRejectsShortSecret(t *testing.T) {
+	cfg := testConfig()
+	cfg.Secret = []byte("too short")
+	if _, err := NewIssuer(cfg); err == nil {
+		t.Error("NewIssuer accepted a short HS256 secret")
+	}
+	if _, err := NewVerifier(cfg); err == nil {
+		t.Error("NewVerifier accepted a short HS256 secret")
+	}
+}
@@ -0,0 +1,375 @@
+package broker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"math/rand"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/streadway/amqp"
+)
+
+const (
+	minReconnectDelay = 500 * time.Millisecond
+	maxReconnectDelay = 30 * time.Second
+
+	// maxIdlePublishers caps how many confirm-mode channels are kept open
+	// between uses.
+	maxIdlePublishers = 8
+)
+
+// consumerCount numbers consumer tags so each subscription can be cancelled
+// on its own.
+var consumerCount int64
+
+// AMQP is a Broker backed by RabbitMQ. When the broker closes the connection,
+// AMQP reconnects with exponential backoff and declares its topology again
+// before handing out channels; subscriptions resume on the new connection.
+// Confirm-mode channels are pooled instead of being opened for every publish.
+type AMQP struct {
+	url      string
+	topology Topology
+
+	mu            sync.Mutex
+	conn          *amqp.Connection
+	state         State
+	generation    int
+	idle          []*confirmPublisher
+	onStateChange []func(State)
+	done          chan struct{}
+}
+
+// NewAMQP returns a broker for the RabbitMQ server at url that declares
+// topology on every connect. Call Connect to dial.
+func NewAMQP(url string, topology Topology) *AMQP {
+	return &AMQP{
+		url:      url,
+		topology: topology,
+		done:     make(chan struct{}),
+	}
+}
+
+// OnStateChange implements Broker.
+func (b *AMQP) OnStateChange(fn func(State)) {
+	b.mu.Lock()
+	b.onStateChange = append(b.onStateChange, fn)
+	state := b.state
+	b.mu.Unlock()
+
+	fn(state)
+}
+
+// Connect dials the broker and declares the topology. Once it succeeds the
+// connection is restored in the background whenever it drops.
+func (b *AMQP) Connect() error {
+	conn, err := b.dial()
+	if err != nil {
+		return err
+	}
+	b.setConnection(conn)
+	go b.watch(conn)
+	return nil
+}
+
+// State returns the current state of the connection.
+func (b *AMQP) State() State {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.state
+}
+
+// Publish implements Publisher using publisher confirms and mandatory
+// routing.
+func (b *AMQP) Publish(ctx context.Context, exchange, routingKey string, msg Message) error {
+	p, err := b.publisher()
+	if err != nil {
+		return err
+	}
+
+	err = p.publish(ctx, exchange, routingKey, amqp.Publishing{
+		Headers:      amqp.Table(msg.Headers),
+		ContentType:  msg.ContentType,
+		DeliveryMode: amqp.Persistent,
+		MessageId:    msg.ID,
+		Type:         msg.Type,
+		Body:         msg.Body,
+	})
+	// The channel survives unroutable and nacked messages; anything else
+	// means it is closed or out of step with its confirmations
+	healthy := err == nil || errors.Is(err, ErrUnroutable) || errors.Is(err, ErrNacked)
+	b.release(p, healthy)
+	return err
+}
+
+// publisher takes a confirm-mode publisher from the pool, opening a new
+// channel if none is idle. Return it with release.
+func (b *AMQP) publisher() (*confirmPublisher, error) {
+	b.mu.Lock()
+	switch b.state {
+	case Connected:
+	case Closed:
+		b.mu.Unlock()
+		return nil, ErrClosed
+	default:
+		b.mu.Unlock()
+		return nil, ErrDisconnected
+	}
+	if n := len(b.idle); n > 0 {
+		p := b.idle[n-1]
+		b.idle = b.idle[:n-1]
+		b.mu.Unlock()
+		return p, nil
+	}
+	conn, generation := b.conn, b.generation
+	b.mu.Unlock()
+
+	ch, err := conn.Channel()
+	if err != nil {
+		return nil, err
+	}
+	p, err := newConfirmPublisher(ch)
+	if err != nil {
+		ch.Close()
+		return nil, err
+	}
+	p.generation = generation
+	return p, nil
+}
+
+// release returns a publisher to the pool, or closes it if it is unhealthy
+// or belongs to an earlier connection.
+func (b *AMQP) release(p *confirmPublisher, healthy bool) {
+	b.mu.Lock()
+	if healthy && b.state == Connected && p.generation == b.generation && len(b.idle) < maxIdlePublishers {
+		b.idle = append(b.idle, p)
+		b.mu.Unlock()
+		return
+	}
+	b.mu.Unlock()
+	p.ch.Close()
+}
+
+// Subscribe implements Subscriber. Rejected messages are not requeued, so
+// they go to the queue's dead letter exchange if it has one.
+func (b *AMQP) Subscribe(ctx context.Context, queue string, opts SubscribeOptions, handler Handler) error {
+	workers := opts.Workers
+	if workers <= 0 {
+		workers = 1
+	}
+
+	for {
+		err := b.consume(ctx, queue, opts.Prefetch, workers, handler)
+		if ctx.Err() != nil {
+			return nil
+		}
+		if errors.Is(err, ErrClosed) {
+			return err
+		}
+		if err != nil {
+			log.Printf("Subscription to %s interrupted: %v", queue, err)
+		}
+
+		// Wait for the connection to come back before consuming again
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-b.done:
+			return ErrClosed
+		case <-time.After(minReconnectDelay):
+		}
+	}
+}
+
+// consume runs one subscription on the current connection until ctx ends or
+// the channel closes.
+func (b *AMQP) consume(ctx context.Context, queue string, prefetch, workers int, handler Handler) error {
+	b.mu.Lock()
+	state, conn := b.state, b.conn
+	b.mu.Unlock()
+	switch state {
+	case Connected:
+	case Closed:
+		return ErrClosed
+	default:
+		return ErrDisconnected
+	}
+
+	ch, err := conn.Channel()
+	if err != nil {
+		return err
+	}
+	defer ch.Close()
+
+	if prefetch > 0 {
+		if err := ch.Qos(prefetch, 0, false); err != nil {
+			return err
+		}
+	}
+	tag := fmt.Sprintf("%s-%d", queue, atomic.AddInt64(&consumerCount, 1))
+	deliveries, err := ch.Consume(queue, tag, false, false, false, false, nil)
+	if err != nil {
+		return err
+	}
+
+	// Handlers get a context that outlives ctx so in-flight messages can
+	// finish after the subscription is cancelled
+	handlerCtx := context.Background()
+
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for d := range deliveries {
+				msg := Message{
+					ID:          d.MessageId,
+					Type:        d.Type,
+					ContentType: d.ContentType,
+					Headers:     d.Headers,
+					Body:        d.Body,
+				}
+				if err := handler(handlerCtx, msg); err != nil {
+					if err := d.Nack(false, false); err != nil {
+						log.Printf("Failed to reject message %s: %v", d.MessageId, err)
+					}
+					continue
+				}
+				if err := d.Ack(false); err != nil {
+					log.Printf("Failed to ack message %s: %v", d.MessageId, err)
+				}
+			}
+		}()
+	}
+
+	// Stop receiving new messages once ctx ends, then drain the ones
+	// already delivered; deliveries closes once the broker confirms
+	stopped := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(stopped)
+	}()
+	select {
+	case <-stopped:
+		return amqp.ErrClosed
+	case <-ctx.Done():
+		if err := ch.Cancel(tag, false); err != nil {
+			log.Printf("Failed to cancel consumer %s: %v", tag, err)
+		}
+		<-stopped
+		return nil
+	}
+}
+
+// Close implements Broker.
+func (b *AMQP) Close() error {
+	b.mu.Lock()
+	if b.state == Closed {
+		b.mu.Unlock()
+		return nil
+	}
+	conn := b.conn
+	b.idle = nil
+	b.mu.Unlock()
+
+	close(b.done)
+	b.setState(Closed)
+	if conn == nil {
+		return nil
+	}
+	return conn.Close()
+}
+
+func (b *AMQP) dial() (*amqp.Connection, error) {
+	conn, err := amqp.Dial(b.url)
+	if err != nil {
+		return nil, err
+	}
+	ch, err := conn.Channel()
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	defer ch.Close()
+	if err := b.topology.declare(ch); err != nil {
+		conn.Close()
+		return nil, err
+	}
+	return conn, nil
+}
+
+// watch waits for conn to close and reconnects until it succeeds or the
+// broker is closed.
+func (b *AMQP) watch(conn *amqp.Connection) {
+	for {
+		closed := conn.NotifyClose(make(chan *amqp.Error, 1))
+		select {
+		case <-b.done:
+			return
+		case reason := <-closed:
+			log.Printf("RabbitMQ connection lost: %v", reason)
+		}
+		b.setState(Disconnected)
+
+		delay := minReconnectDelay
+		for {
+			select {
+			case <-b.done:
+				return
+			case <-time.After(delay + time.Duration(rand.Int63n(int64(delay)/2))):
+			}
+
+			var err error
+			conn, err = b.dial()
+			if err == nil {
+				break
+			}
+			log.Printf("Failed to reconnect to RabbitMQ: %v", err)
+			if delay *= 2; delay > maxReconnectDelay {
+				delay = maxReconnectDelay
+			}
+		}
+
+		select {
+		case <-b.done:
+			conn.Close()
+			return
+		default:
+		}
+		log.Printf("Reconnected to RabbitMQ")
+		b.setConnection(conn)
+	}
+}
+
+// setConnection makes conn current and drops the channels of the old one.
+func (b *AMQP) setConnection(conn *amqp.Connection) {
+	b.mu.Lock()
+	b.conn = conn
+	b.generation++
+	idle := b.idle
+	b.idle = nil
+	b.mu.Unlock()
+
+	for _, p := range idle {
+		p.ch.Close()
+	}
+	b.setState(Connected)
+}
+
+func (b *AMQP) setState(state State) {
+	b.mu.Lock()
+	if b.state == state || b.state == Closed {
+		b.mu.Unlock()
+		return
+	}
+	b.state = state
+	callbacks := b.onStateChange
+	b.mu.Unlock()
+
+	for _, fn := range callbacks {
+		fn(state)
+	}
+}
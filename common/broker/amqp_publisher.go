@@ -0,0 +1,62 @@
+package broker
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/streadway/amqp"
+)
+
+// confirmPublisher publishes mandatory messages on a channel in confirm mode
+// and waits for the broker to take responsibility for each one. It is not
+// safe for concurrent use.
+type confirmPublisher struct {
+	ch       *amqp.Channel
+	confirms chan amqp.Confirmation
+	returns  chan amqp.Return
+
+	// generation identifies the connection the channel belongs to.
+	generation int
+}
+
+// newConfirmPublisher puts ch into confirm mode. The channel must not be used
+// for publishing by anything else afterwards.
+func newConfirmPublisher(ch *amqp.Channel) (*confirmPublisher, error) {
+	if err := ch.Confirm(false); err != nil {
+		return nil, err
+	}
+	return &confirmPublisher{
+		ch:       ch,
+		confirms: ch.NotifyPublish(make(chan amqp.Confirmation, 1)),
+		returns:  ch.NotifyReturn(make(chan amqp.Return, 1)),
+	}, nil
+}
+
+// publish sends msg and blocks until the broker confirms it. If ctx ends
+// first the channel's confirmations can no longer be matched to messages, so
+// the caller must discard the publisher.
+func (p *confirmPublisher) publish(ctx context.Context, exchange, key string, msg amqp.Publishing) error {
+	if err := p.ch.Publish(exchange, key, true, false, msg); err != nil {
+		return err
+	}
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case confirm, ok := <-p.confirms:
+		if !ok {
+			return amqp.ErrClosed
+		}
+		// The broker sends basic.return before basic.ack, so any return
+		// for this message has already been queued
+		select {
+		case r := <-p.returns:
+			return fmt.Errorf("%w: %s (%d %s)", ErrUnroutable, key, r.ReplyCode, r.ReplyText)
+		default:
+		}
+		if !confirm.Ack {
+			return ErrNacked
+		}
+		return nil
+	}
+}
@@ -0,0 +1,137 @@
+// Package broker lets services publish and consume messages without depending
+// on a particular message broker. AMQP talks to RabbitMQ; Memory keeps
+// everything in process for local development and tests.
+package broker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+)
+
+var (
+	// ErrUnroutable is returned when no queue is bound to receive a
+	// published message.
+	ErrUnroutable = errors.New("broker: message was not routed to any queue")
+
+	// ErrNacked is returned when the broker refused responsibility for a
+	// message.
+	ErrNacked = errors.New("broker: message was not acknowledged by the broker")
+
+	// ErrDisconnected is returned while the connection to the broker is down.
+	ErrDisconnected = errors.New("broker: not connected")
+
+	// ErrClosed is returned once the broker has been closed.
+	ErrClosed = errors.New("broker: closed")
+)
+
+// Message is a message as published and as delivered to subscribers.
+type Message struct {
+	ID          string
+	Type        string
+	ContentType string
+	Headers     map[string]interface{}
+	Body        []byte
+}
+
+// Publisher publishes messages to an exchange. Publish returns once the
+// broker has taken responsibility for the message, and fails with
+// ErrUnroutable if no queue is bound to receive it.
+type Publisher interface {
+	Publish(ctx context.Context, exchange, routingKey string, msg Message) error
+}
+
+// Handler processes a delivered message. Returning nil acknowledges it;
+// returning an error rejects it to the queue's dead letter queue, if any.
+type Handler func(ctx context.Context, msg Message) error
+
+// SubscribeOptions tune how a subscription consumes its queue.
+type SubscribeOptions struct {
+	// Prefetch is the maximum number of unacknowledged messages held at
+	// once. Zero means no limit.
+	Prefetch int
+	// Workers is the number of messages handled concurrently. Defaults to 1.
+	Workers int
+}
+
+// Subscriber consumes queues.
+type Subscriber interface {
+	// Subscribe calls handler for every message on queue until ctx is done,
+	// then stops receiving, waits for in-flight handlers and returns.
+	Subscribe(ctx context.Context, queue string, opts SubscribeOptions, handler Handler) error
+}
+
+// State is the state of the connection to a broker.
+type State int
+
+const (
+	Disconnected State = iota
+	Connected
+	Closed
+)
+
+func (s State) String() string {
+	switch s {
+	case Connected:
+		return "connected"
+	case Closed:
+		return "closed"
+	default:
+		return "disconnected"
+	}
+}
+
+// Broker is a connection to a message broker.
+type Broker interface {
+	Publisher
+	Subscriber
+
+	// OnStateChange registers fn to be called with the current state and
+	// on every later change.
+	OnStateChange(fn func(State))
+	Close() error
+}
+
+// Kinds of broker that Open can create.
+const (
+	KindAMQP   = "amqp"
+	KindMemory = "memory"
+)
+
+// Config selects and configures a broker.
+type Config struct {
+	// Kind is KindAMQP or KindMemory.
+	Kind string
+	// URL is the AMQP URL to dial.
+	URL string
+}
+
+// ConfigFromEnv reads the BROKER (amqp or memory) and BROKER_URL environment
+// variables, falling back to AMQP at defaultURL.
+func ConfigFromEnv(defaultURL string) Config {
+	cfg := Config{Kind: os.Getenv("BROKER"), URL: os.Getenv("BROKER_URL")}
+	if cfg.Kind == "" {
+		cfg.Kind = KindAMQP
+	}
+	if cfg.URL == "" {
+		cfg.URL = defaultURL
+	}
+	return cfg
+}
+
+// Open returns a connected broker with topology declared.
+func Open(cfg Config, topology Topology) (Broker, error) {
+	switch cfg.Kind {
+	case KindAMQP:
+		b := NewAMQP(cfg.URL, topology)
+		if err := b.Connect(); err != nil {
+			return nil, err
+		}
+		return b, nil
+	case KindMemory:
+		return NewMemory(topology), nil
+	default:
+		return nil, fmt.Errorf("broker: unknown kind %q", cfg.Kind)
+	}
+}
@@ -0,0 +1,31 @@
+package broker
+
+import (
+	"context"
+	"log"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// PublishEvent announces an event of type eventType to exchange, routed by
+// its type, with payload as JSON in the body. Publishing is best effort:
+// failures are logged and not returned, so that the change the event
+// describes still succeeds.
+func PublishEvent(ctx context.Context, p Publisher, exchange, eventType string, payload proto.Message) {
+	body, err := protojson.Marshal(payload)
+	if err != nil {
+		log.Printf("Failed to encode %s event: %v", eventType, err)
+		return
+	}
+	err = p.Publish(ctx, exchange, eventType, Message{
+		ID:          uuid.NewString(),
+		Type:        eventType,
+		ContentType: "application/json",
+		Body:        body,
+	})
+	if err != nil {
+		log.Printf("Failed to publish %s event: %v", eventType, err)
+	}
+}
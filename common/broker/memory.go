@@ -0,0 +1,262 @@
+package broker
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// Published is a message recorded by Memory.
+type Published struct {
+	Exchange   string
+	RoutingKey string
+	Message    Message
+}
+
+// Memory is an in-process Broker. It routes messages through its topology
+// the way RabbitMQ would, including the default exchange and dead lettering,
+// and records everything published so tests can assert on it.
+type Memory struct {
+	mu        sync.Mutex
+	exchanges map[string]string
+	queues    map[string]*memoryQueue
+	published []Published
+	closed    bool
+	done      chan struct{}
+}
+
+type memoryQueue struct {
+	Queue
+
+	mu       sync.Mutex
+	messages []Message
+	ready    chan struct{}
+}
+
+// NewMemory returns an in-process broker with topology declared.
+func NewMemory(topology Topology) *Memory {
+	m := &Memory{
+		exchanges: make(map[string]string),
+		queues:    make(map[string]*memoryQueue),
+		done:      make(chan struct{}),
+	}
+	for _, e := range topology.Exchanges {
+		m.exchanges[e.Name] = e.Kind
+	}
+	for _, q := range topology.Queues {
+		if q.DeadLetterExchange != "" {
+			dead := DeadLetterQueue(q.Name)
+			m.queues[dead] = newMemoryQueue(Queue{
+				Name:     dead,
+				Bindings: []Binding{{Exchange: q.DeadLetterExchange, Key: q.Name}},
+			})
+		}
+		m.queues[q.Name] = newMemoryQueue(q)
+	}
+	return m
+}
+
+func newMemoryQueue(q Queue) *memoryQueue {
+	return &memoryQueue{Queue: q, ready: make(chan struct{}, 1)}
+}
+
+func (q *memoryQueue) push(msg Message) {
+	q.mu.Lock()
+	q.messages = append(q.messages, msg)
+	q.mu.Unlock()
+	q.signal()
+}
+
+func (q *memoryQueue) pop() (Message, bool) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if len(q.messages) == 0 {
+		return Message{}, false
+	}
+	msg := q.messages[0]
+	q.messages = q.messages[1:]
+	if len(q.messages) > 0 {
+		// Wake another worker for the rest
+		q.signal()
+	}
+	return msg, true
+}
+
+func (q *memoryQueue) signal() {
+	select {
+	case q.ready <- struct{}{}:
+	default:
+	}
+}
+
+// Published returns every message published so far, in order.
+func (m *Memory) Published() []Published {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]Published(nil), m.published...)
+}
+
+// Publish implements Publisher.
+func (m *Memory) Publish(ctx context.Context, exchange, routingKey string, msg Message) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.closed {
+		return ErrClosed
+	}
+	queues, err := m.route(exchange, routingKey)
+	if err != nil {
+		return err
+	}
+	if len(queues) == 0 {
+		return fmt.Errorf("%w: %s", ErrUnroutable, routingKey)
+	}
+	m.published = append(m.published, Published{Exchange: exchange, RoutingKey: routingKey, Message: msg})
+	for _, q := range queues {
+		q.push(msg)
+	}
+	return nil
+}
+
+// route returns the queues a message published to exchange with routingKey
+// reaches. The caller must hold m.mu.
+func (m *Memory) route(exchange, routingKey string) ([]*memoryQueue, error) {
+	// The default exchange routes straight to the queue named by the key
+	if exchange == "" {
+		if q, ok := m.queues[routingKey]; ok {
+			return []*memoryQueue{q}, nil
+		}
+		return nil, nil
+	}
+
+	kind, ok := m.exchanges[exchange]
+	if !ok {
+		return nil, fmt.Errorf("broker: no exchange %q", exchange)
+	}
+	var queues []*memoryQueue
+	for _, q := range m.queues {
+		for _, b := range q.Bindings {
+			if b.Exchange == exchange && bindingMatches(kind, b.Key, routingKey) {
+				queues = append(queues, q)
+				break
+			}
+		}
+	}
+	return queues, nil
+}
+
+func bindingMatches(kind, pattern, routingKey string) bool {
+	switch kind {
+	case ExchangeFanout:
+		return true
+	case ExchangeTopic:
+		return topicMatches(strings.Split(pattern, "."), strings.Split(routingKey, "."))
+	default:
+		return pattern == routingKey
+	}
+}
+
+// topicMatches matches dot-separated words the way a topic exchange does:
+// "*" stands for exactly one word and "#" for zero or more.
+func topicMatches(pattern, words []string) bool {
+	if len(pattern) == 0 {
+		return len(words) == 0
+	}
+	switch pattern[0] {
+	case "#":
+		for i := 0; i <= len(words); i++ {
+			if topicMatches(pattern[1:], words[i:]) {
+				return true
+			}
+		}
+		return false
+	case "*":
+		return len(words) > 0 && topicMatches(pattern[1:], words[1:])
+	default:
+		return len(words) > 0 && pattern[0] == words[0] && topicMatches(pattern[1:], words[1:])
+	}
+}
+
+// Subscribe implements Subscriber. Rejected messages go to the queue's dead
+// letter queue if it has one and are dropped otherwise.
+func (m *Memory) Subscribe(ctx context.Context, queue string, opts SubscribeOptions, handler Handler) error {
+	m.mu.Lock()
+	q, ok := m.queues[queue]
+	m.mu.Unlock()
+	if !ok {
+		return fmt.Errorf("broker: no queue %q", queue)
+	}
+
+	workers := opts.Workers
+	if workers <= 0 {
+		workers = 1
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				msg, ok := q.pop()
+				if !ok {
+					select {
+					case <-ctx.Done():
+						return
+					case <-m.done:
+						return
+					case <-q.ready:
+						continue
+					}
+				}
+				if err := handler(context.Background(), msg); err != nil && q.DeadLetterExchange != "" {
+					m.deadLetter(q, msg)
+				}
+				if ctx.Err() != nil {
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	select {
+	case <-m.done:
+		return ErrClosed
+	default:
+		return nil
+	}
+}
+
+func (m *Memory) deadLetter(q *memoryQueue, msg Message) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	queues, _ := m.route(q.DeadLetterExchange, q.Name)
+	for _, dead := range queues {
+		dead.push(msg)
+	}
+}
+
+// OnStateChange implements Broker. A Memory broker is connected until it is
+// closed.
+func (m *Memory) OnStateChange(fn func(State)) {
+	m.mu.Lock()
+	closed := m.closed
+	m.mu.Unlock()
+	if closed {
+		fn(Closed)
+		return
+	}
+	fn(Connected)
+}
+
+// Close implements Broker.
+func (m *Memory) Close() error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if !m.closed {
+		m.closed = true
+		close(m.done)
+	}
+	return nil
+}
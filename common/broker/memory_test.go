@@ -0,0 +1,186 @@
+package broker
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestTopicMatches(t *testing.T) {
+	tests := []struct {
+		pattern, key string
+		want         bool
+	}{
+		{"user.created", "user.created", true},
+		{"user.created", "user.deleted", false},
+		{"user.created", "user.created.v2", false},
+		{"user.*", "user.created", true},
+		{"user.*", "user", false},
+		{"user.*", "user.created.v2", false},
+		{"*.created", "book.created", true},
+		{"*.*", "book.created", true},
+		{"user.#", "user", true},
+		{"user.#", "user.created", true},
+		{"user.#", "user.created.v2", true},
+		{"user.#", "book.created", false},
+		{"#", "anything.at.all", true},
+		{"#.created", "created", true},
+		{"#.created", "book.created", true},
+		{"#.created", "book.created.v2", false},
+		{"#.created.#", "a.b.created.c.d", true},
+		{"user.*.#", "user", false},
+		{"user.*.#", "user.created", true},
+		{"user.#.v2", "user.v2", true},
+		{"user.#.v2", "user.created.v2", true},
+		{"user.#.v2", "user.created.v3", false},
+	}
+	for _, tt := range tests {
+		got := topicMatches(strings.Split(tt.pattern, "."), strings.Split(tt.key, "."))
+		if got != tt.want {
+			t.Errorf("topicMatches(%q, %q) = %v, want %v", tt.pattern, tt.key, got, tt.want)
+		}
+	}
+}
+
+// testTopology has a topic, a fanout and a direct exchange, and a queue
+// dead lettering to a direct exchange.
+var testTopology = Topology{
+	Exchanges: []Exchange{
+		{Name: "events", Kind: ExchangeTopic},
+		{Name: "broadcast", Kind: ExchangeFanout},
+		{Name: "direct", Kind: ExchangeDirect},
+		{Name: "dead", Kind: ExchangeDirect},
+	},
+	Queues: []Queue{
+		{Name: "users", Bindings: []Binding{{Exchange: "events", Key: "user.*"}}, DeadLetterExchange: "dead"},
+		{Name: "audit", Bindings: []Binding{{Exchange: "events", Key: "#"}, {Exchange: "broadcast"}}},
+		{Name: "mail", Bindings: []Binding{{Exchange: "direct", Key: "mail"}}},
+	},
+}
+
+// queued returns the number of messages waiting in queue.
+func queued(m *Memory, queue string) int {
+	q := m.queues[queue]
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return len(q.messages)
+}
+
+func TestMemoryRouting(t *testing.T) {
+	tests := []struct {
+		exchange, key string
+		want          map[string]int
+		wantErr       error
+	}{
+		{"events", "user.created", map[string]int{"users": 1, "audit": 1}, nil},
+		{"events", "book.created", map[string]int{"audit": 1}, nil},
+		{"broadcast", "ignored", map[string]int{"audit": 1}, nil},
+		{"direct", "mail", map[string]int{"mail": 1}, nil},
+		{"direct", "sms", nil, ErrUnroutable},
+		{"", "mail", map[string]int{"mail": 1}, nil},
+		{"", "nowhere", nil, ErrUnroutable},
+	}
+	for _, tt := range tests {
+		m := NewMemory(testTopology)
+		err := m.Publish(context.Background(), tt.exchange, tt.key, Message{ID: "1"})
+		if !errors.Is(err, tt.wantErr) {
+			t.Errorf("Publish(%q, %q) error = %v, want %v", tt.exchange, tt.key, err, tt.wantErr)
+			continue
+		}
+		for name := range m.queues {
+			if got := queued(m, name); got != tt.want[name] {
+				t.Errorf("Publish(%q, %q): queue %s holds %d messages, want %d", tt.exchange, tt.key, name, got, tt.want[name])
+			}
+		}
+	}
+
+	m := NewMemory(testTopology)
+	if err := m.Publish(context.Background(), "missing", "key", Message{}); err == nil {
+		t.Error("Publish to an undeclared exchange succeeded")
+	}
+}
+
+func TestMemoryDeadLetter(t *testing.T) {
+	m := NewMemory(testTopology)
+	defer m.Close()
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	for _, id := range []string{"ok", "fail"} {
+		if err := m.Publish(ctx, "events", "user.created", Message{ID: id}); err != nil {
+			t.Fatalf("Publish: %v", err)
+		}
+	}
+
+	// Reject one message and stop once both were handled
+	handled := make(chan string, 2)
+	subCtx, stop := context.WithCancel(ctx)
+	go func() {
+		for i := 0; i < 2; i++ {
+			select {
+			case <-handled:
+			case <-ctx.Done():
+			}
+		}
+		stop()
+	}()
+	err := m.Subscribe(subCtx, "users", SubscribeOptions{}, func(ctx context.Context, msg Message) error {
+		handled <- msg.ID
+		if msg.ID == "fail" {
+			return errors.New("rejected")
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Subscribe: %v", err)
+	}
+
+	dead := DeadLetterQueue("users")
+	if got := queued(m, dead); got != 1 {
+		t.Fatalf("dead letter queue holds %d messages, want 1", got)
+	}
+	msg, _ := m.queues[dead].pop()
+	if msg.ID != "fail" {
+		t.Errorf("dead lettered message %q, want %q", msg.ID, "fail")
+	}
+	if got := queued(m, "users"); got != 0 {
+		t.Errorf("queue users holds %d messages after handling, want 0", got)
+	}
+}
+
+func TestMemoryRejectWithoutDeadLetter(t *testing.T) {
+	m := NewMemory(testTopology)
+	defer m.Close()
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if err := m.Publish(ctx, "direct", "mail", Message{ID: "1"}); err != nil {
+		t.Fatalf("Publish: %v", err)
+	}
+	subCtx, stop := context.WithCancel(ctx)
+	err := m.Subscribe(subCtx, "mail", SubscribeOptions{}, func(ctx context.Context, msg Message) error {
+		stop()
+		return errors.New("rejected")
+	})
+	if err != nil {
+		t.Fatalf("Subscribe: %v", err)
+	}
+	for name := range m.queues {
+		if got := queued(m, name); got != 0 {
+			t.Errorf("queue %s holds %d messages, want the rejected message dropped", name, got)
+		}
+	}
+}
+
+func TestMemoryClosed(t *testing.T) {
+	m := NewMemory(testTopology)
+	m.Close()
+	if err := m.Publish(context.Background(), "direct", "mail", Message{}); !errors.Is(err, ErrClosed) {
+		t.Errorf("Publish after Close error = %v, want ErrClosed", err)
+	}
+	if err := m.Subscribe(context.Background(), "mail", SubscribeOptions{}, nil); !errors.Is(err, ErrClosed) {
+		t.Errorf("Subscribe after Close error = %v, want ErrClosed", err)
+	}
+}
@@ -0,0 +1,80 @@
+package broker
+
+import "github.com/streadway/amqp"
+
+// Exchange kinds.
+const (
+	ExchangeDirect = "direct"
+	ExchangeTopic  = "topic"
+	ExchangeFanout = "fanout"
+)
+
+// Topology describes the exchanges, queues and bindings a service relies on.
+// Declaring it is idempotent, so every process declares it at startup.
+type Topology struct {
+	Exchanges []Exchange
+	Queues    []Queue
+}
+
+// Exchange is a durable exchange.
+type Exchange struct {
+	Name string
+	Kind string
+}
+
+// Queue is a durable queue and the bindings that feed it.
+type Queue struct {
+	Name     string
+	Bindings []Binding
+	// DeadLetterExchange, when set, receives the messages rejected from
+	// this queue. They are routed by queue name to DeadLetterQueue(Name),
+	// which is declared alongside.
+	DeadLetterExchange string
+}
+
+// Binding routes messages published to Exchange with a matching Key.
+type Binding struct {
+	Exchange string
+	Key      string
+}
+
+// DeadLetterQueue returns the name of the queue holding the rejected messages
+// of queue.
+func DeadLetterQueue(queue string) string {
+	return queue + ".dead"
+}
+
+// declare creates everything in the topology that does not exist yet on a
+// RabbitMQ channel.
+func (t Topology) declare(ch *amqp.Channel) error {
+	for _, e := range t.Exchanges {
+		if err := ch.ExchangeDeclare(e.Name, e.Kind, true, false, false, false, nil); err != nil {
+			return err
+		}
+	}
+	for _, q := range t.Queues {
+		var args amqp.Table
+		if q.DeadLetterExchange != "" {
+			dead := DeadLetterQueue(q.Name)
+			if _, err := ch.QueueDeclare(dead, true, false, false, false, nil); err != nil {
+				return err
+			}
+			if err := ch.QueueBind(dead, q.Name, q.DeadLetterExchange, false, nil); err != nil {
+				return err
+			}
+			args = amqp.Table{
+				"x-dead-letter-exchange":    q.DeadLetterExchange,
+				"x-dead-letter-routing-key": q.Name,
+			}
+		}
+		if _, err := ch.QueueDeclare(q.Name, true, false, false, false, args); err != nil {
+			return err
+		}
+		for _, b := range q.Bindings {
+			if err := ch.QueueBind(q.Name, b.Key, b.Exchange, false, nil); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,32 @@
+// Package caller identifies who is making a request, for audit columns such
+// as deleted_by.
+package caller
+
+import (
+	"context"
+
+	"google.golang.org/grpc/metadata"
+
+	"common/auth"
+)
+
+// Anonymous is the caller of requests that do not identify anyone.
+const Anonymous = "anonymous"
+
+// userIDKey is the metadata key carrying the caller's user id. The gateway
+// forwards it from the Grpc-Metadata-X-User-Id header.
+const userIDKey = "x-user-id"
+
+// ID returns the id of the user making the request, or Anonymous. The
+// subject of a verified token wins over the x-user-id metadata, which
+// callers can set to anything.
+func ID(ctx context.Context) string {
+	if claims, ok := auth.FromContext(ctx); ok {
+		return claims.Subject
+	}
+	md, _ := metadata.FromIncomingContext(ctx)
+	if values := md.Get(userIDKey); len(values) > 0 && values[0] != "" {
+		return values[0]
+	}
+	return Anonymous
+}
@@ -0,0 +1,141 @@
+// Package dberror turns database errors into gRPC status errors, so callers
+// see NOT_FOUND or ALREADY_EXISTS rather than UNKNOWN with the driver's
+// message. Both pgx and lib/pq errors are understood.
+package dberror
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
+	"github.com/lib/pq"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/runtime/protoiface"
+)
+
+// ErrorInfo reasons attached to mapped errors.
+const (
+	ReasonNotFound            = "NOT_FOUND"
+	ReasonAlreadyExists       = "ALREADY_EXISTS"
+	ReasonConstraintViolation = "CONSTRAINT_VIOLATION"
+)
+
+// PostgreSQL SQLSTATE codes of integrity constraint violations.
+const (
+	notNullViolation    = "23502"
+	foreignKeyViolation = "23503"
+	uniqueViolation     = "23505"
+	checkViolation      = "23514"
+)
+
+// Mapper maps the database errors of one service.
+type Mapper struct {
+	// Domain identifies the service in ErrorInfo details.
+	Domain string
+
+	// Fields names the request field each constraint guards, so that
+	// violations can be reported against it in a BadRequest detail.
+	// Constraints not listed fall back to the violating column, if known.
+	Fields map[string]string
+}
+
+// constraintError is the driver-independent part of a constraint violation.
+type constraintError struct {
+	code       string
+	constraint string
+	column     string
+}
+
+func asConstraintError(err error) (constraintError, bool) {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		return constraintError{code: pgErr.Code, constraint: pgErr.ConstraintName, column: pgErr.ColumnName}, true
+	}
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) {
+		return constraintError{code: string(pqErr.Code), constraint: pqErr.Constraint, column: pqErr.Column}, true
+	}
+	return constraintError{}, false
+}
+
+// Status returns err as a gRPC status error. Errors that already carry a
+// status are returned unchanged; errors it does not recognise become
+// INTERNAL without the original message, which may hold SQL or data.
+func (m Mapper) Status(err error) error {
+	if err == nil {
+		return nil
+	}
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+
+	switch {
+	case errors.Is(err, pgx.ErrNoRows), errors.Is(err, sql.ErrNoRows):
+		return m.detailedStatus(codes.NotFound, "resource not found", ReasonNotFound, "", "")
+	case errors.Is(err, context.Canceled):
+		return status.Error(codes.Canceled, err.Error())
+	case errors.Is(err, context.DeadlineExceeded):
+		return status.Error(codes.DeadlineExceeded, err.Error())
+	}
+
+	ce, ok := asConstraintError(err)
+	if !ok {
+		return status.Error(codes.Internal, "internal error")
+	}
+	field := m.Fields[ce.constraint]
+	if field == "" {
+		field = ce.column
+	}
+
+	switch ce.code {
+	case uniqueViolation:
+		return m.detailedStatus(codes.AlreadyExists, "resource already exists", ReasonAlreadyExists, field, "must be unique")
+	case checkViolation:
+		return m.detailedStatus(codes.InvalidArgument, "invalid value", ReasonConstraintViolation, field, "value is not allowed")
+	case notNullViolation:
+		return m.detailedStatus(codes.InvalidArgument, "missing value", ReasonConstraintViolation, field, "is required")
+	case foreignKeyViolation:
+		return m.detailedStatus(codes.FailedPrecondition, "referenced resource does not exist or is still referenced",
+			ReasonConstraintViolation, field, "")
+	default:
+		return status.Error(codes.Internal, "internal error")
+	}
+}
+
+// detailedStatus returns a status error with an ErrorInfo detail and, when both
+// the field and a description are known, a BadRequest detail for the field.
+func (m Mapper) detailedStatus(code codes.Code, msg, reason, field, description string) error {
+	info := &errdetails.ErrorInfo{Reason: reason, Domain: m.Domain}
+	if field != "" {
+		info.Metadata = map[string]string{"field": field}
+	}
+	details := []protoiface.MessageV1{info}
+	if field != "" && description != "" {
+		details = append(details, &errdetails.BadRequest{
+			FieldViolations: []*errdetails.BadRequest_FieldViolation{{Field: field, Description: description}},
+		})
+	}
+
+	st := status.New(code, msg)
+	if detailed, err := st.WithDetails(details...); err == nil {
+		return detailed.Err()
+	}
+	return st.Err()
+}
+
+// UnaryServerInterceptor maps the errors returned by every handler of a
+// server.
+func (m Mapper) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		resp, err := handler(ctx, req)
+		if err != nil {
+			return nil, m.Status(err)
+		}
+		return resp, nil
+	}
+}
@@ -0,0 +1,105 @@
+// Package etag implements optimistic concurrency control on top of a
+// resource's version number. The version travels in the request message or,
+// through the gateway, in an If-Match header; a stale version is reported
+// as FAILED_PRECONDITION carrying the current version.
+package etag
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// ReasonVersionMismatch is the ErrorInfo reason of stale updates.
+	ReasonVersionMismatch = "VERSION_MISMATCH"
+
+	// CurrentVersionKey is the ErrorInfo metadata key holding the current
+	// version of the resource.
+	CurrentVersionKey = "current_version"
+)
+
+// If-Match arrives as "if-match" from gRPC clients and with the gateway's
+// prefix for permanent HTTP headers from HTTP clients.
+var ifMatchKeys = []string{"if-match", "grpcgateway-if-match"}
+
+// Format returns the ETag of a version.
+func Format(version int32) string {
+	return strconv.Quote(strconv.Itoa(int(version)))
+}
+
+// Parse returns the version an ETag refers to. Weak and unquoted tags are
+// accepted.
+func Parse(tag string) (int32, error) {
+	tag = strings.TrimPrefix(strings.TrimSpace(tag), "W/")
+	tag = strings.Trim(tag, `"`)
+	version, err := strconv.ParseInt(tag, 10, 32)
+	if err != nil || version <= 0 {
+		return 0, fmt.Errorf("invalid etag %q", tag)
+	}
+	return int32(version), nil
+}
+
+// Expected returns the version the caller based its update on: version from
+// the request message if set, otherwise the If-Match header. It fails with
+// INVALID_ARGUMENT when neither is present.
+func Expected(ctx context.Context, version int32) (int32, error) {
+	if version != 0 {
+		return version, nil
+	}
+	md, _ := metadata.FromIncomingContext(ctx)
+	for _, key := range ifMatchKeys {
+		if values := md.Get(key); len(values) > 0 {
+			version, err := Parse(values[0])
+			if err != nil {
+				return 0, status.Error(codes.InvalidArgument, err.Error())
+			}
+			return version, nil
+		}
+	}
+	return 0, status.Error(codes.InvalidArgument, "version or If-Match header is required")
+}
+
+// MismatchError reports that resource is at version current rather than the
+// version the caller expected.
+func MismatchError(domain, resource string, current int32) error {
+	st := status.Newf(codes.FailedPrecondition, "%s has been modified, current version is %d", resource, current)
+	detailed, err := st.WithDetails(&errdetails.ErrorInfo{
+		Reason: ReasonVersionMismatch,
+		Domain: domain,
+		Metadata: map[string]string{
+			CurrentVersionKey: strconv.Itoa(int(current)),
+		},
+	})
+	if err != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
+
+// CurrentVersion extracts the current version from an error returned by
+// MismatchError.
+func CurrentVersion(err error) (int32, bool) {
+	st, ok := status.FromError(err)
+	if !ok || st.Code() != codes.FailedPrecondition {
+		return 0, false
+	}
+	for _, detail := range st.Details() {
+		info, ok := detail.(*errdetails.ErrorInfo)
+		if !ok || info.GetReason() != ReasonVersionMismatch {
+			continue
+		}
+		version, err := strconv.ParseInt(info.GetMetadata()[CurrentVersionKey], 10, 32)
+		if err != nil {
+			return 0, false
+		}
+		return int32(version), true
+	}
+	return 0, false
+}
@@ -0,0 +1,46 @@
+// Package fieldmask resolves the update_mask of partial update requests.
+package fieldmask
+
+import (
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+// Paths returns the fields an update writes, in the order of updatable. An
+// empty mask or "*" selects every updatable field, which keeps full
+// replacement working for callers that send no mask. Paths listed in ignored
+// are dropped, so a body that repeats e.g. the id or version can be turned
+// into a mask as is; any other path outside updatable fails with
+// INVALID_ARGUMENT.
+func Paths(mask *fieldmaskpb.FieldMask, updatable []string, ignored ...string) ([]string, error) {
+	if len(mask.GetPaths()) == 0 {
+		return updatable, nil
+	}
+
+	selected := make(map[string]bool, len(mask.GetPaths()))
+	for _, path := range mask.GetPaths() {
+		if path == "*" {
+			return updatable, nil
+		}
+		selected[path] = true
+	}
+	for _, path := range ignored {
+		delete(selected, path)
+	}
+
+	var paths []string
+	for _, path := range updatable {
+		if selected[path] {
+			paths = append(paths, path)
+			delete(selected, path)
+		}
+	}
+	for path := range selected {
+		return nil, status.Errorf(codes.InvalidArgument, "update_mask: field %q cannot be updated", path)
+	}
+	if len(paths) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "update_mask selects no updatable fields")
+	}
+	return paths, nil
+}
@@ -0,0 +1,49 @@
+package fieldmask
+
+import (
+	"reflect"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+var updatable = []string{"title", "author", "price", "genres"}
+
+func TestPaths(t *testing.T) {
+	tests := []struct {
+		name    string
+		mask    []string
+		ignored []string
+		want    []string
+		code    codes.Code
+	}{
+		{"no mask", nil, nil, updatable, codes.OK},
+		{"wildcard", []string{"*"}, nil, updatable, codes.OK},
+		{"wildcard among paths", []string{"price", "*"}, nil, updatable, codes.OK},
+		{"single path", []string{"price"}, nil, []string{"price"}, codes.OK},
+		{"order of updatable", []string{"genres", "title"}, nil, []string{"title", "genres"}, codes.OK},
+		{"duplicate path", []string{"price", "price"}, nil, []string{"price"}, codes.OK},
+		{"ignored path dropped", []string{"id", "price", "version"}, []string{"id", "version"}, []string{"price"}, codes.OK},
+		{"unknown path", []string{"price", "isbn"}, nil, nil, codes.InvalidArgument},
+		{"ignored path not given", []string{"id", "price"}, nil, nil, codes.InvalidArgument},
+		{"only ignored paths", []string{"id", "version"}, []string{"id", "version"}, nil, codes.InvalidArgument},
+		{"nested path", []string{"book.price"}, nil, nil, codes.InvalidArgument},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var mask *fieldmaskpb.FieldMask
+			if tt.mask != nil {
+				mask = &fieldmaskpb.FieldMask{Paths: tt.mask}
+			}
+			got, err := Paths(mask, updatable, tt.ignored...)
+			if code := status.Code(err); code != tt.code {
+				t.Fatalf("Paths(%v) error = %v, want code %v", tt.mask, err, tt.code)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Paths(%v) = %v, want %v", tt.mask, got, tt.want)
+			}
+		})
+	}
+}
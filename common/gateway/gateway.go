@@ -0,0 +1,76 @@
+// Package gateway configures the grpc-gateway muxes that expose the services
+// over HTTP.
+package gateway
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	"common/etag"
+)
+
+// NewServeMux returns a gateway mux that sets ETag headers on versioned
+// resources and reports stale updates as 412 Precondition Failed. Other errors
+// get the status code grpc-gateway maps their gRPC code to, with the status
+// details in the body, so every service answers alike. Errors asking to retry
+// later carry a Retry-After header. Authorization headers reach the services as
+// "authorization" metadata.
+func NewServeMux(opts ...runtime.ServeMuxOption) *runtime.ServeMux {
+	opts = append([]runtime.ServeMuxOption{
+		runtime.WithErrorHandler(errorHandler),
+		runtime.WithForwardResponseOption(setETag),
+	}, opts...)
+	return runtime.NewServeMux(opts...)
+}
+
+// versioned is implemented by messages with a version field.
+type versioned interface {
+	GetVersion() int32
+}
+
+func setETag(ctx context.Context, w http.ResponseWriter, msg proto.Message) error {
+	if v, ok := msg.(versioned); ok && v.GetVersion() != 0 {
+		w.Header().Set("ETag", etag.Format(v.GetVersion()))
+	}
+	return nil
+}
+
+func errorHandler(ctx context.Context, mux *runtime.ServeMux, marshaler runtime.Marshaler, w http.ResponseWriter, r *http.Request, err error) {
+	if delay, ok := retryDelay(err); ok {
+		w.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(delay.Seconds()))))
+	}
+	if current, ok := etag.CurrentVersion(err); ok {
+		w.Header().Set("ETag", etag.Format(current))
+		w = &statusWriter{ResponseWriter: w, status: http.StatusPreconditionFailed}
+	}
+	runtime.DefaultHTTPErrorHandler(ctx, mux, marshaler, w, r, err)
+}
+
+// retryDelay returns the delay of the RetryInfo detail of err, if any.
+func retryDelay(err error) (time.Duration, bool) {
+	for _, detail := range status.Convert(err).Details() {
+		if info, ok := detail.(*errdetails.RetryInfo); ok && info.GetRetryDelay() != nil {
+			return info.GetRetryDelay().AsDuration(), true
+		}
+	}
+	return 0, false
+}
+
+// statusWriter replaces the status code written by the default error
+// handler.
+type statusWriter struct {
+	http.ResponseWriter
+	status int
+}
+
+func (w *statusWriter) WriteHeader(int) {
+	w.ResponseWriter.WriteHeader(w.status)
+}
@@ -0,0 +1,37 @@
+// Package orderby parses the order_by field of list requests.
+package orderby
+
+import (
+	"fmt"
+	"strings"
+)
+
+// DefaultColumn is the column results are sorted on when order_by is empty.
+const DefaultColumn = "id"
+
+// Parse turns an order_by value such as "price desc" into a column name and
+// direction. Columns for which sortable returns false are refused.
+func Parse(orderBy string, sortable func(column string) bool) (column string, desc bool, err error) {
+	fields := strings.Fields(strings.ToLower(orderBy))
+	switch len(fields) {
+	case 0:
+		return DefaultColumn, false, nil
+	case 1:
+		column = fields[0]
+	case 2:
+		column = fields[0]
+		switch fields[1] {
+		case "asc":
+		case "desc":
+			desc = true
+		default:
+			return "", false, fmt.Errorf("invalid sort direction %q", fields[1])
+		}
+	default:
+		return "", false, fmt.Errorf("invalid order_by %q", orderBy)
+	}
+	if !sortable(column) {
+		return "", false, fmt.Errorf("cannot sort by %q", column)
+	}
+	return column, desc, nil
+}
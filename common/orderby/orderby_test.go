@@ -0,0 +1,31 @@
+package orderby
+
+import "testing"
+
+func TestParse(t *testing.T) {
+	sortable := func(column string) bool { return column == "id" || column == "price" }
+	tests := []struct {
+		orderBy string
+		column  string
+		desc    bool
+		wantErr bool
+	}{
+		{"", "id", false, false},
+		{"price", "price", false, false},
+		{"price asc", "price", false, false},
+		{"  Price   DESC ", "price", true, false},
+		{"price sideways", "", false, true},
+		{"price desc id", "", false, true},
+		{"title", "", false, true},
+	}
+	for _, tt := range tests {
+		column, desc, err := Parse(tt.orderBy, sortable)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("Parse(%q) error = %v, want error %v", tt.orderBy, err, tt.wantErr)
+			continue
+		}
+		if column != tt.column || desc != tt.desc {
+			t.Errorf("Parse(%q) = %q, %v; want %q, %v", tt.orderBy, column, desc, tt.column, tt.desc)
+		}
+	}
+}
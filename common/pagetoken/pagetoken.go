@@ -0,0 +1,32 @@
+// Package pagetoken ties page tokens to the list request they were issued
+// for, so that a token cannot be replayed with other filters or another
+// sort order and silently skip or repeat results.
+package pagetoken
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// pagingFields may change from one page to the next.
+var pagingFields = []protoreflect.Name{"page_token", "page_size"}
+
+// Fingerprint returns a short hash of every field of the list request req
+// except page_token and page_size. Callers should normalise fields such as
+// order_by before hashing, so equivalent requests share a fingerprint.
+func Fingerprint(req proto.Message) string {
+	m := proto.Clone(req).ProtoReflect()
+	for _, name := range pagingFields {
+		if fd := m.Descriptor().Fields().ByName(name); fd != nil {
+			m.Clear(fd)
+		}
+	}
+	// Deterministic marshaling still may differ between library versions;
+	// at worst a token is refused after an upgrade
+	data, _ := proto.MarshalOptions{Deterministic: true}.Marshal(m.Interface())
+	sum := sha256.Sum256(data)
+	return base64.RawURLEncoding.EncodeToString(sum[:12])
+}
@@ -0,0 +1,166 @@
+// Package validation enforces the validation rules declared on request
+// messages with protoc-gen-validate annotations.
+package validation
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+// validator is implemented by messages with generated validation code.
+type validator interface {
+	ValidateAll() error
+}
+
+// fieldError is implemented by the errors the generated code returns for a
+// single field.
+type fieldError interface {
+	Field() string
+	Reason() string
+	Cause() error
+}
+
+// multiError is implemented by the errors ValidateAll returns when several
+// fields are invalid.
+type multiError interface {
+	AllErrors() []error
+}
+
+// masked is implemented by partial update requests.
+type masked interface {
+	GetUpdateMask() *fieldmaskpb.FieldMask
+}
+
+// UnaryServerInterceptor rejects requests that break their validation rules
+// with INVALID_ARGUMENT and a BadRequest detail listing every violation by
+// its proto field path, e.g. "book.title".
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if err := Validate(req); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// Validate checks req against its validation rules. Fields an update mask
+// leaves out are not checked, since they are not written.
+func Validate(req interface{}) error {
+	v, ok := req.(validator)
+	if !ok {
+		return nil
+	}
+	err := v.ValidateAll()
+	if err == nil {
+		return nil
+	}
+
+	msg, ok := req.(proto.Message)
+	if !ok {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	fieldViolations := violations(err, msg.ProtoReflect().Descriptor(), "")
+	if m, ok := req.(masked); ok {
+		fieldViolations = maskViolations(fieldViolations, m.GetUpdateMask().GetPaths())
+	}
+	if len(fieldViolations) == 0 {
+		return nil
+	}
+
+	st := status.New(codes.InvalidArgument, "invalid "+fieldViolations[0].Field+": "+fieldViolations[0].Description)
+	detailed, detailsErr := st.WithDetails(&errdetails.BadRequest{FieldViolations: fieldViolations})
+	if detailsErr != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
+
+// violations flattens a validation error into field violations, translating
+// the Go field names the generated code reports into proto field paths.
+func violations(err error, desc protoreflect.MessageDescriptor, prefix string) []*errdetails.BadRequest_FieldViolation {
+	var multi multiError
+	if errors.As(err, &multi) {
+		var all []*errdetails.BadRequest_FieldViolation
+		for _, e := range multi.AllErrors() {
+			all = append(all, violations(e, desc, prefix)...)
+		}
+		return all
+	}
+
+	var fe fieldError
+	if !errors.As(err, &fe) {
+		return []*errdetails.BadRequest_FieldViolation{{Field: prefix, Description: err.Error()}}
+	}
+
+	// Repeated and map items are reported as e.g. "Genres[0]"
+	name, index := fe.Field(), ""
+	if i := strings.IndexByte(name, '['); i >= 0 {
+		name, index = name[:i], name[i:]
+	}
+	field := fieldByGoName(desc, name)
+	path := name
+	if field != nil {
+		path = string(field.Name())
+	}
+	path += index
+	if prefix != "" {
+		path = prefix + "." + path
+	}
+
+	if cause := fe.Cause(); cause != nil && field != nil && field.Message() != nil {
+		return violations(cause, field.Message(), path)
+	}
+	return []*errdetails.BadRequest_FieldViolation{{Field: path, Description: fe.Reason()}}
+}
+
+// fieldByGoName finds the field whose generated Go name is name.
+func fieldByGoName(desc protoreflect.MessageDescriptor, name string) protoreflect.FieldDescriptor {
+	fields := desc.Fields()
+	for i := 0; i < fields.Len(); i++ {
+		field := fields.Get(i)
+		if strings.EqualFold(strings.ReplaceAll(string(field.Name()), "_", ""), name) {
+			return field
+		}
+	}
+	return nil
+}
+
+// maskViolations drops violations inside the updated resource that concern
+// fields outside the update mask. An empty mask or "*" keeps everything.
+func maskViolations(fieldViolations []*errdetails.BadRequest_FieldViolation, paths []string) []*errdetails.BadRequest_FieldViolation {
+	selected := make(map[string]bool, len(paths))
+	for _, path := range paths {
+		if path == "*" {
+			return fieldViolations
+		}
+		selected[path] = true
+	}
+	if len(selected) == 0 {
+		return fieldViolations
+	}
+
+	var kept []*errdetails.BadRequest_FieldViolation
+	for _, v := range fieldViolations {
+		parts := strings.SplitN(v.Field, ".", 3)
+		if len(parts) >= 2 {
+			field := parts[1]
+			if i := strings.IndexByte(field, '['); i >= 0 {
+				field = field[:i]
+			}
+			if !selected[field] {
+				continue
+			}
+		}
+		kept = append(kept, v)
+	}
+	return kept
+}
@@ -0,0 +1,211 @@
+package validation
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+// requestDescriptor describes
+//
+//	message Book { string title = 1; repeated string genres = 2; int32 min_price = 3; }
+//	message UpdateBookRequest { int64 id = 1; Book book = 2; }
+var requestDescriptor = func() protoreflect.MessageDescriptor {
+	field := func(name string, number int32, typ descriptorpb.FieldDescriptorProto_Type, label descriptorpb.FieldDescriptorProto_Label, typeName string) *descriptorpb.FieldDescriptorProto {
+		f := &descriptorpb.FieldDescriptorProto{
+			Name:   proto.String(name),
+			Number: proto.Int32(number),
+			Type:   typ.Enum(),
+			Label:  label.Enum(),
+		}
+		if typeName != "" {
+			f.TypeName = proto.String(typeName)
+		}
+		return f
+	}
+	optional := descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL
+	file, err := protodesc.NewFile(&descriptorpb.FileDescriptorProto{
+		Name:    proto.String("validation_test.proto"),
+		Package: proto.String("validationtest"),
+		Syntax:  proto.String("proto3"),
+		MessageType: []*descriptorpb.DescriptorProto{
+			{
+				Name: proto.String("Book"),
+				Field: []*descriptorpb.FieldDescriptorProto{
+					field("title", 1, descriptorpb.FieldDescriptorProto_TYPE_STRING, optional, ""),
+					field("genres", 2, descriptorpb.FieldDescriptorProto_TYPE_STRING, descriptorpb.FieldDescriptorProto_LABEL_REPEATED, ""),
+					field("min_price", 3, descriptorpb.FieldDescriptorProto_TYPE_INT32, optional, ""),
+				},
+			},
+			{
+				Name: proto.String("UpdateBookRequest"),
+				Field: []*descriptorpb.FieldDescriptorProto{
+					field("id", 1, descriptorpb.FieldDescriptorProto_TYPE_INT64, optional, ""),
+					field("book", 2, descriptorpb.FieldDescriptorProto_TYPE_MESSAGE, optional, ".validationtest.Book"),
+				},
+			},
+		},
+	}, nil)
+	if err != nil {
+		panic(err)
+	}
+	return file.Messages().ByName("UpdateBookRequest")
+}()
+
+// fieldErr mimics the per-field errors protoc-gen-validate generates.
+type fieldErr struct {
+	field, reason string
+	cause         error
+}
+
+func (e fieldErr) Field() string  { return e.field }
+func (e fieldErr) Reason() string { return e.reason }
+func (e fieldErr) Cause() error   { return e.cause }
+func (e fieldErr) Error() string  { return e.field + ": " + e.reason }
+
+// multiErr mimics the errors ValidateAll generates for several fields.
+type multiErr []error
+
+func (m multiErr) AllErrors() []error { return m }
+func (m multiErr) Error() string      { return "several fields are invalid" }
+
+// createRequest is a request without an update mask failing with err.
+type createRequest struct {
+	*dynamicpb.Message
+	err error
+}
+
+func (r createRequest) ValidateAll() error { return r.err }
+
+// updateRequest is a partial update failing with err.
+type updateRequest struct {
+	createRequest
+	mask []string
+}
+
+func (r updateRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if r.mask == nil {
+		return nil
+	}
+	return &fieldmaskpb.FieldMask{Paths: r.mask}
+}
+
+// bookErr reports the given errors of the embedded book the way the
+// generated code of the request does.
+func bookErr(errs ...error) error {
+	return fieldErr{field: "Book", reason: "embedded message failed validation", cause: multiErr(errs)}
+}
+
+var (
+	emptyTitle = fieldErr{field: "Title", reason: "value length must be at least 1 runes"}
+	emptyGenre = fieldErr{field: "Genres[0]", reason: "value length must be at least 1 runes"}
+	badPrice   = fieldErr{field: "MinPrice", reason: "value must be greater than or equal to 0"}
+	badID      = fieldErr{field: "Id", reason: "value must be greater than 0"}
+)
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name string
+		req  interface{}
+		want []string
+	}{
+		{"valid", createRequest{err: nil}, nil},
+		{"create reports every field",
+			createRequest{err: bookErr(emptyTitle, emptyGenre, badPrice)},
+			[]string{"book.title", "book.genres[0]", "book.min_price"}},
+		{"top-level field", createRequest{err: multiErr{badID}}, []string{"id"}},
+		{"unknown Go field name kept", createRequest{err: fieldErr{field: "Shelf", reason: "bad"}}, []string{"Shelf"}},
+		{"patch of price ignores an empty title",
+			updateRequest{createRequest{err: bookErr(emptyTitle)}, []string{"price"}},
+			nil},
+		{"patch of title reports an empty title",
+			updateRequest{createRequest{err: bookErr(emptyTitle, badPrice)}, []string{"title"}},
+			[]string{"book.title"}},
+		{"patch keeps items of masked repeated fields",
+			updateRequest{createRequest{err: bookErr(emptyTitle, emptyGenre)}, []string{"genres"}},
+			[]string{"book.genres[0]"}},
+		{"patch with snake case path",
+			updateRequest{createRequest{err: bookErr(emptyTitle, badPrice)}, []string{"min_price"}},
+			[]string{"book.min_price"}},
+		{"empty mask checks everything",
+			updateRequest{createRequest{err: bookErr(emptyTitle, badPrice)}, nil},
+			[]string{"book.title", "book.min_price"}},
+		{"wildcard mask checks everything",
+			updateRequest{createRequest{err: bookErr(emptyTitle)}, []string{"*"}},
+			[]string{"book.title"}},
+		{"fields outside the resource are always checked",
+			updateRequest{createRequest{err: multiErr{badID, bookErr(emptyTitle)}}, []string{"price"}},
+			[]string{"id"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Validate(withMessage(tt.req))
+			if tt.want == nil {
+				if err != nil {
+					t.Fatalf("Validate error = %v, want nil", err)
+				}
+				return
+			}
+			st := status.Convert(err)
+			if st.Code() != codes.InvalidArgument {
+				t.Fatalf("Validate code = %v (%v), want InvalidArgument", st.Code(), err)
+			}
+			if got := violatedFields(st); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("violations = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateOtherErrors(t *testing.T) {
+	// Errors that name no field are reported against the message
+	err := Validate(withMessage(createRequest{err: errors.New("something is off")}))
+	st := status.Convert(err)
+	if st.Code() != codes.InvalidArgument {
+		t.Fatalf("Validate code = %v, want InvalidArgument", st.Code())
+	}
+	if got := violatedFields(st); !reflect.DeepEqual(got, []string{""}) {
+		t.Errorf("violations = %q, want one without a field", got)
+	}
+
+	// Requests without validation rules pass
+	if err := Validate(struct{}{}); err != nil {
+		t.Errorf("Validate(no rules) = %v, want nil", err)
+	}
+}
+
+// withMessage gives a test request a message of requestDescriptor.
+func withMessage(req interface{}) interface{} {
+	msg := dynamicpb.NewMessage(requestDescriptor)
+	switch r := req.(type) {
+	case createRequest:
+		r.Message = msg
+		return r
+	case updateRequest:
+		r.Message = msg
+		return r
+	}
+	return req
+}
+
+func violatedFields(st *status.Status) []string {
+	var fields []string
+	for _, detail := range st.Details() {
+		if br, ok := detail.(*errdetails.BadRequest); ok {
+			for _, v := range br.GetFieldViolations() {
+				fields = append(fields, v.GetField())
+			}
+		}
+	}
+	return fields
+}
@@ -5,10 +5,10 @@ import (
 	"log"
 	"net/http"
 
-	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"google.golang.org/grpc"
 
 	pb "UserService/userserver/test" // Update with the correct path
+	"common/gateway"
 )
 
 func main() {
@@ -16,7 +16,7 @@ func main() {
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
 
-	mux := runtime.NewServeMux()
+	mux := gateway.NewServeMux()
 	opts := []grpc.DialOption{grpc.WithInsecure()}
 
 	err := pb.RegisterUserServiceHandlerFromEndpoint(ctx, mux, "localhost:50051", opts)
@@ -3,9 +3,13 @@ module UserService
 go 1.19
 
 require (
-	github.com/google/uuid v1.3.0 // indirect
+	common v0.0.0
+	github.com/envoyproxy/protoc-gen-validate v1.0.2
+	github.com/google/uuid v1.3.0
 	github.com/jackc/pgx v3.6.2+incompatible // indirect
 	github.com/pkg/errors v0.9.1 // indirect
-	golang.org/x/crypto v0.10.0 // indirect
+	golang.org/x/crypto v0.10.0
 	golang.org/x/text v0.10.0 // indirect
 )
+
+replace common => ../common
@@ -0,0 +1,86 @@
+// Package mailer sends the emails of the user service. Local development
+// uses the log or file mailers, which deliver nothing.
+package mailer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"time"
+)
+
+// Message is a plain text email.
+type Message struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+// Mailer delivers emails.
+type Mailer interface {
+	Send(ctx context.Context, msg Message) error
+}
+
+// Kinds of mailer that FromEnv can create.
+const (
+	KindLog  = "log"
+	KindFile = "file"
+)
+
+// FromEnv returns the mailer selected by the MAILER environment variable:
+// "log" or "file", which writes to the MAILER_DIR directory. MAILER must be
+// set, since both write the codes in the emails where others may read them.
+func FromEnv() (Mailer, error) {
+	switch kind := os.Getenv("MAILER"); kind {
+	case "":
+		return nil, errors.New("mailer: MAILER is not set")
+	case KindLog:
+		return LogMailer{}, nil
+	case KindFile:
+		dir := os.Getenv("MAILER_DIR")
+		if dir == "" {
+			dir = "mail"
+		}
+		return NewFileMailer(dir)
+	default:
+		return nil, fmt.Errorf("mailer: unknown kind %q", kind)
+	}
+}
+
+// LogMailer writes every message, codes included, to the standard logger.
+// It is meant for local development only.
+type LogMailer struct{}
+
+// Send implements Mailer.
+func (LogMailer) Send(ctx context.Context, msg Message) error {
+	log.Printf("Mail to %s: %s\n%s", msg.To, msg.Subject, msg.Body)
+	return nil
+}
+
+// FileMailer writes every message to its own file in a directory.
+type FileMailer struct {
+	dir   string
+	count int64
+}
+
+// NewFileMailer returns a mailer writing to dir, creating it if needed.
+func NewFileMailer(dir string) (*FileMailer, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+	return &FileMailer{dir: dir}, nil
+}
+
+// Send implements Mailer.
+func (m *FileMailer) Send(ctx context.Context, msg Message) error {
+	name := fmt.Sprintf("%s-%d.eml", time.Now().UTC().Format("20060102T150405"), atomic.AddInt64(&m.count, 1))
+	var b strings.Builder
+	fmt.Fprintf(&b, "To: %s\r\nSubject: %s\r\nDate: %s\r\n\r\n%s\r\n",
+		msg.To, msg.Subject, time.Now().Format(time.RFC1123Z), msg.Body)
+	return os.WriteFile(filepath.Join(m.dir, name), []byte(b.String()), 0o600)
+}
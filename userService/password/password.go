@@ -0,0 +1,242 @@
+// Package password hashes user passwords with bcrypt or argon2id. Hashes
+// are self-describing, so passwords hashed with older parameters can still
+// be verified and rehashed on the user's next login.
+package password
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// Algorithms a Hasher can use for new hashes.
+const (
+	Bcrypt   = "bcrypt"
+	Argon2id = "argon2id"
+)
+
+const (
+	argon2SaltLen = 16
+	argon2KeyLen  = 32
+)
+
+// ErrUnknownHash is returned for stored hashes in no format this package
+// understands.
+var ErrUnknownHash = errors.New("password: unknown hash format")
+
+// Params selects the algorithm and cost of new hashes.
+type Params struct {
+	// Algorithm is Bcrypt or Argon2id.
+	Algorithm string
+
+	// BcryptCost is the bcrypt work factor.
+	BcryptCost int
+
+	// Argon2Time is the number of argon2id passes, Argon2Memory the memory
+	// used in KiB and Argon2Threads the degree of parallelism.
+	Argon2Time    uint32
+	Argon2Memory  uint32
+	Argon2Threads uint8
+}
+
+// DefaultParams follow the OWASP recommendations for argon2id.
+func DefaultParams() Params {
+	return Params{
+		Algorithm:     Argon2id,
+		BcryptCost:    12,
+		Argon2Time:    3,
+		Argon2Memory:  64 * 1024,
+		Argon2Threads: 2,
+	}
+}
+
+// ParamsFromEnv reads PASSWORD_HASH (bcrypt or argon2id), BCRYPT_COST,
+// ARGON2_TIME, ARGON2_MEMORY (KiB) and ARGON2_THREADS, falling back to
+// DefaultParams for those that are unset.
+func ParamsFromEnv() (Params, error) {
+	p := DefaultParams()
+	if v := os.Getenv("PASSWORD_HASH"); v != "" {
+		p.Algorithm = v
+	}
+	for _, env := range []struct {
+		name string
+		bits int
+		set  func(uint64)
+	}{
+		{"BCRYPT_COST", 8, func(n uint64) { p.BcryptCost = int(n) }},
+		{"ARGON2_TIME", 32, func(n uint64) { p.Argon2Time = uint32(n) }},
+		{"ARGON2_MEMORY", 32, func(n uint64) { p.Argon2Memory = uint32(n) }},
+		{"ARGON2_THREADS", 8, func(n uint64) { p.Argon2Threads = uint8(n) }},
+	} {
+		v := os.Getenv(env.name)
+		if v == "" {
+			continue
+		}
+		n, err := strconv.ParseUint(v, 10, env.bits)
+		if err != nil {
+			return p, fmt.Errorf("password: invalid %s: %w", env.name, err)
+		}
+		env.set(n)
+	}
+	return p, nil
+}
+
+// Hasher hashes and verifies passwords.
+type Hasher struct {
+	params Params
+}
+
+// NewHasher returns a Hasher creating hashes with params.
+func NewHasher(params Params) (*Hasher, error) {
+	switch params.Algorithm {
+	case Bcrypt:
+		if params.BcryptCost < bcrypt.MinCost || params.BcryptCost > bcrypt.MaxCost {
+			return nil, fmt.Errorf("password: bcrypt cost must be between %d and %d", bcrypt.MinCost, bcrypt.MaxCost)
+		}
+	case Argon2id:
+		if params.Argon2Time == 0 || params.Argon2Memory == 0 || params.Argon2Threads == 0 {
+			return nil, errors.New("password: argon2id time, memory and threads must be positive")
+		}
+	default:
+		return nil, fmt.Errorf("password: unknown algorithm %q", params.Algorithm)
+	}
+	return &Hasher{params: params}, nil
+}
+
+// Hash returns a new salted hash of plaintext.
+func (h *Hasher) Hash(plaintext string) ([]byte, error) {
+	if h.params.Algorithm == Bcrypt {
+		return bcrypt.GenerateFromPassword([]byte(plaintext), h.params.BcryptCost)
+	}
+
+	salt := make([]byte, argon2SaltLen)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, err
+	}
+	p := argon2Params{
+		time:    h.params.Argon2Time,
+		memory:  h.params.Argon2Memory,
+		threads: h.params.Argon2Threads,
+		salt:    salt,
+	}
+	p.key = argon2.IDKey([]byte(plaintext), salt, p.time, p.memory, p.threads, argon2KeyLen)
+	return p.encode(), nil
+}
+
+// Verify reports whether plaintext matches hash, which may have been
+// created with any algorithm and parameters.
+func (h *Hasher) Verify(plaintext string, hash []byte) (bool, error) {
+	switch {
+	case isBcrypt(hash):
+		err := bcrypt.CompareHashAndPassword(hash, []byte(plaintext))
+		if errors.Is(err, bcrypt.ErrMismatchedHashAndPassword) {
+			return false, nil
+		}
+		return err == nil, err
+	case bytes.HasPrefix(hash, []byte("$argon2id$")):
+		p, err := decodeArgon2(hash)
+		if err != nil {
+			return false, err
+		}
+		key := argon2.IDKey([]byte(plaintext), p.salt, p.time, p.memory, p.threads, uint32(len(p.key)))
+		return subtle.ConstantTimeCompare(key, p.key) == 1, nil
+	default:
+		return false, ErrUnknownHash
+	}
+}
+
+// IsHash reports whether hash is in a format Verify understands. Anything
+// else in the password column predates hashing and is a plaintext password.
+func IsHash(hash []byte) bool {
+	if isBcrypt(hash) {
+		_, err := bcrypt.Cost(hash)
+		return err == nil
+	}
+	if !bytes.HasPrefix(hash, []byte("$argon2id$")) {
+		return false
+	}
+	_, err := decodeArgon2(hash)
+	return err == nil
+}
+
+// NeedsRehash reports whether hash was created with an algorithm or
+// parameters other than the current ones.
+func (h *Hasher) NeedsRehash(hash []byte) bool {
+	switch {
+	case isBcrypt(hash):
+		if h.params.Algorithm != Bcrypt {
+			return true
+		}
+		cost, err := bcrypt.Cost(hash)
+		return err != nil || cost != h.params.BcryptCost
+	case bytes.HasPrefix(hash, []byte("$argon2id$")):
+		if h.params.Algorithm != Argon2id {
+			return true
+		}
+		p, err := decodeArgon2(hash)
+		return err != nil ||
+			p.time != h.params.Argon2Time ||
+			p.memory != h.params.Argon2Memory ||
+			p.threads != h.params.Argon2Threads ||
+			len(p.key) != argon2KeyLen
+	default:
+		return true
+	}
+}
+
+func isBcrypt(hash []byte) bool {
+	return bytes.HasPrefix(hash, []byte("$2a$")) ||
+		bytes.HasPrefix(hash, []byte("$2b$")) ||
+		bytes.HasPrefix(hash, []byte("$2y$"))
+}
+
+// argon2Params is a decoded argon2id hash in the PHC string format:
+// $argon2id$v=19$m=65536,t=3,p=2$<salt>$<key>.
+type argon2Params struct {
+	time    uint32
+	memory  uint32
+	threads uint8
+	salt    []byte
+	key     []byte
+}
+
+func (p argon2Params) encode() []byte {
+	return []byte(fmt.Sprintf("$argon2id$v=%d$m=%d,t=%d,p=%d$%s$%s",
+		argon2.Version, p.memory, p.time, p.threads,
+		base64.RawStdEncoding.EncodeToString(p.salt),
+		base64.RawStdEncoding.EncodeToString(p.key)))
+}
+
+func decodeArgon2(hash []byte) (argon2Params, error) {
+	var p argon2Params
+	parts := strings.Split(string(hash), "$")
+	if len(parts) != 6 {
+		return p, ErrUnknownHash
+	}
+
+	var version int
+	if _, err := fmt.Sscanf(parts[2], "v=%d", &version); err != nil || version != argon2.Version {
+		return p, fmt.Errorf("password: unsupported argon2 version %q", parts[2])
+	}
+	if _, err := fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &p.memory, &p.time, &p.threads); err != nil {
+		return p, fmt.Errorf("password: invalid argon2 parameters: %w", err)
+	}
+
+	var err error
+	if p.salt, err = base64.RawStdEncoding.DecodeString(parts[4]); err != nil {
+		return p, fmt.Errorf("password: invalid argon2 salt: %w", err)
+	}
+	if p.key, err = base64.RawStdEncoding.DecodeString(parts[5]); err != nil {
+		return p, fmt.Errorf("password: invalid argon2 key: %w", err)
+	}
+	return p, nil
+}
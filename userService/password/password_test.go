@@ -0,0 +1,150 @@
+package password
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+// testParams keep the hashes cheap enough for tests.
+func testParams(algorithm string) Params {
+	return Params{
+		Algorithm:     algorithm,
+		BcryptCost:    bcrypt.MinCost,
+		Argon2Time:    1,
+		Argon2Memory:  1024,
+		Argon2Threads: 1,
+	}
+}
+
+func newTestHasher(t *testing.T, params Params) *Hasher {
+	t.Helper()
+	h, err := NewHasher(params)
+	if err != nil {
+		t.Fatalf("NewHasher: %v", err)
+	}
+	return h
+}
+
+func TestHashVerify(t *testing.T) {
+	for _, algorithm := range []string{Bcrypt, Argon2id} {
+		t.Run(algorithm, func(t *testing.T) {
+			h := newTestHasher(t, testParams(algorithm))
+			hash, err := h.Hash("correct horse")
+			if err != nil {
+				t.Fatalf("Hash: %v", err)
+			}
+			if !IsHash(hash) {
+				t.Errorf("IsHash(%s) = false", hash)
+			}
+			if ok, err := h.Verify("correct horse", hash); !ok || err != nil {
+				t.Errorf("Verify(right password) = %v, %v; want true", ok, err)
+			}
+			if ok, err := h.Verify("battery staple", hash); ok || err != nil {
+				t.Errorf("Verify(wrong password) = %v, %v; want false", ok, err)
+			}
+
+			again, err := h.Hash("correct horse")
+			if err != nil {
+				t.Fatalf("Hash: %v", err)
+			}
+			if bytes.Equal(hash, again) {
+				t.Error("hashing twice gave the same hash; salt is not random")
+			}
+		})
+	}
+}
+
+func TestVerifyAcrossAlgorithms(t *testing.T) {
+	bcryptHash, err := bcrypt.GenerateFromPassword([]byte("secret"), bcrypt.MinCost)
+	if err != nil {
+		t.Fatal(err)
+	}
+	argon2Hash, err := newTestHasher(t, testParams(Argon2id)).Hash("secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Whatever new hashes use, hashes of either algorithm still verify
+	for _, algorithm := range []string{Bcrypt, Argon2id} {
+		h := newTestHasher(t, testParams(algorithm))
+		for _, hash := range [][]byte{bcryptHash, argon2Hash} {
+			if ok, err := h.Verify("secret", hash); !ok || err != nil {
+				t.Errorf("%s hasher: Verify(%s) = %v, %v; want true", algorithm, hash, ok, err)
+			}
+		}
+	}
+}
+
+func TestVerifyUnknownHash(t *testing.T) {
+	h := newTestHasher(t, testParams(Argon2id))
+	for _, hash := range []string{"", "secret", "$1$salt$hash"} {
+		if IsHash([]byte(hash)) {
+			t.Errorf("IsHash(%q) = true", hash)
+		}
+		if _, err := h.Verify("secret", []byte(hash)); !errors.Is(err, ErrUnknownHash) {
+			t.Errorf("Verify(%q) error = %v, want ErrUnknownHash", hash, err)
+		}
+		if !h.NeedsRehash([]byte(hash)) {
+			t.Errorf("NeedsRehash(%q) = false", hash)
+		}
+	}
+}
+
+func TestNeedsRehash(t *testing.T) {
+	base := testParams(Argon2id)
+	hash, err := newTestHasher(t, base).Hash("secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	bcryptHash, err := newTestHasher(t, testParams(Bcrypt)).Hash("secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name   string
+		change func(*Params)
+		hash   []byte
+		want   bool
+	}{
+		{"same argon2id parameters", func(*Params) {}, hash, false},
+		{"argon2id time", func(p *Params) { p.Argon2Time++ }, hash, true},
+		{"argon2id memory", func(p *Params) { p.Argon2Memory *= 2 }, hash, true},
+		{"argon2id threads", func(p *Params) { p.Argon2Threads++ }, hash, true},
+		{"argon2id to bcrypt", func(p *Params) { p.Algorithm = Bcrypt }, hash, true},
+		{"same bcrypt cost", func(p *Params) { p.Algorithm = Bcrypt }, bcryptHash, false},
+		{"bcrypt cost", func(p *Params) { p.Algorithm = Bcrypt; p.BcryptCost++ }, bcryptHash, true},
+		{"bcrypt to argon2id", func(*Params) {}, bcryptHash, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			params := base
+			tt.change(&params)
+			if got := newTestHasher(t, params).NeedsRehash(tt.hash); got != tt.want {
+				t.Errorf("NeedsRehash = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewHasherRejectsBadParams(t *testing.T) {
+	tests := []struct {
+		name   string
+		change func(*Params)
+	}{
+		{"unknown algorithm", func(p *Params) { p.Algorithm = "md5" }},
+		{"bcrypt cost too low", func(p *Params) { p.Algorithm = Bcrypt; p.BcryptCost = bcrypt.MinCost - 1 }},
+		{"bcrypt cost too high", func(p *Params) { p.Algorithm = Bcrypt; p.BcryptCost = bcrypt.MaxCost + 1 }},
+		{"argon2id without passes", func(p *Params) { p.Argon2Time = 0 }},
+	}
+	for _, tt := range tests {
+		params := testParams(Argon2id)
+		tt.change(&params)
+		if _, err := NewHasher(params); err == nil {
+			t.Errorf("%s: NewHasher succeeded, want error", tt.name)
+		}
+	}
+}
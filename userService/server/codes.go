@@ -0,0 +1,104 @@
+package main
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base32"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
+)
+
+// Purposes of single-use codes, and how long each stays valid.
+const (
+	activationPurpose = "activation"
+	activationTTL     = 72 * time.Hour
+
+	passwordResetPurpose = "password_reset"
+	passwordResetTTL     = time.Hour
+
+	mfaPurpose = "mfa"
+	mfaTTL     = 5 * time.Minute
+)
+
+// errInvalidCode is returned by consumeCode for codes that are unknown,
+// expired, already used or meant for something else.
+var errInvalidCode = errors.New("invalid or expired code")
+
+// querier is implemented by both pools and transactions.
+type querier interface {
+	Exec(ctx context.Context, sql string, args ...interface{}) (pgconn.CommandTag, error)
+	QueryRow(ctx context.Context, sql string, args ...interface{}) pgx.Row
+}
+
+// issueCode creates a random single-use code for user id and stores its
+// hash. The plaintext code is returned to be sent to the user and is not
+// kept anywhere.
+func issueCode(ctx context.Context, q querier, id int32, purpose string, ttl time.Duration) (string, error) {
+	code, err := newSecret(16)
+	if err != nil {
+		return "", err
+	}
+
+	_, err = q.Exec(ctx, `
+		INSERT INTO user_codes (hash, user_id, purpose, expires_at)
+		VALUES ($1, $2, $3, now() + $4 * interval '1 second')
+	`, hashCode(code), id, purpose, ttl.Seconds())
+	if err != nil {
+		return "", err
+	}
+	return code, nil
+}
+
+// consumeCode deletes a valid code of purpose and returns the user it was
+// issued to. Pass id 0 to accept a code issued to any user.
+func consumeCode(ctx context.Context, q querier, id int32, purpose, code string) (int32, error) {
+	var owner int32
+	err := q.QueryRow(ctx, `
+		DELETE FROM user_codes
+		WHERE hash = $1 AND purpose = $2 AND ($3 = 0 OR user_id = $3) AND expires_at > now()
+		RETURNING user_id
+	`, hashCode(code), purpose, id).Scan(&owner)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return 0, errInvalidCode
+	}
+	return owner, err
+}
+
+// lookupCode returns the user a valid code of purpose was issued to,
+// leaving the code in place.
+func lookupCode(ctx context.Context, q querier, purpose, code string) (int32, error) {
+	var owner int32
+	err := q.QueryRow(ctx, `
+		SELECT user_id FROM user_codes
+		WHERE hash = $1 AND purpose = $2 AND expires_at > now()
+	`, hashCode(code), purpose).Scan(&owner)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return 0, errInvalidCode
+	}
+	return owner, err
+}
+
+// deleteCodes removes every code of purpose issued to user id.
+func deleteCodes(ctx context.Context, q querier, id int32, purpose string) error {
+	_, err := q.Exec(ctx, `DELETE FROM user_codes WHERE user_id = $1 AND purpose = $2`, id, purpose)
+	return err
+}
+
+// newSecret returns n random bytes as text that survives being copied out
+// of an email or a URL.
+func newSecret(n int) (string, error) {
+	random := make([]byte, n)
+	if _, err := rand.Read(random); err != nil {
+		return "", err
+	}
+	return base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString(random), nil
+}
+
+func hashCode(code string) []byte {
+	sum := sha256.Sum256([]byte(code))
+	return sum[:]
+}
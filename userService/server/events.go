@@ -0,0 +1,27 @@
+package main
+
+import "common/broker"
+
+const (
+	// userEventsExchange is the topic exchange user events are published
+	// to, with the event type as routing key.
+	userEventsExchange = "user_events"
+	userEventsQueue    = "user_events_queue"
+
+	userRegistered = "user.registered"
+	userActivated  = "user.activated"
+	userUpdated    = "user.updated"
+	userDeleted    = "user.deleted"
+)
+
+var userTopology = broker.Topology{
+	Exchanges: []broker.Exchange{
+		{Name: userEventsExchange, Kind: broker.ExchangeTopic},
+	},
+	Queues: []broker.Queue{
+		{
+			Name:     userEventsQueue,
+			Bindings: []broker.Binding{{Exchange: userEventsExchange, Key: "user.#"}},
+		},
+	},
+}
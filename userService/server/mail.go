@@ -0,0 +1,44 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"UserService/mailer"
+	pb "UserService/userserver/test"
+)
+
+// sendActivationMail emails user the code that activates their account.
+// Sending is best effort: failures are logged and registration still
+// succeeds.
+func (s *server) sendActivationMail(ctx context.Context, user *pb.User, code string) {
+	err := s.mail.Send(ctx, mailer.Message{
+		To:      user.GetEmail(),
+		Subject: "Activate your bookstore account",
+		Body: fmt.Sprintf(
+			"Hello %s,\n\nUse this code to activate your account within %d days:\n\n"+
+				"    user id:         %d\n    activation code: %s\n",
+			user.GetName(), int(activationTTL.Hours()/24), user.GetId(), code,
+		),
+	})
+	if err != nil {
+		log.Printf("Failed to send activation email to user %d: %v", user.GetId(), err)
+	}
+}
+
+// sendPasswordResetMail emails user the code that sets a new password.
+func (s *server) sendPasswordResetMail(ctx context.Context, user *pb.User, code string) {
+	err := s.mail.Send(ctx, mailer.Message{
+		To:      user.GetEmail(),
+		Subject: "Reset your bookstore password",
+		Body: fmt.Sprintf(
+			"Hello %s,\n\nUse this code to choose a new password within %d minutes:\n\n"+
+				"    reset code: %s\n\nIf you did not ask for this, ignore this email.\n",
+			user.GetName(), int(passwordResetTTL.Minutes()), code,
+		),
+	})
+	if err != nil {
+		log.Printf("Failed to send password reset email to user %d: %v", user.GetId(), err)
+	}
+}
@@ -0,0 +1,376 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"log"
+	"strings"
+	"time"
+
+	"UserService/totp"
+	pb "UserService/userserver/test"
+
+	"github.com/jackc/pgx/v4"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// totpSkew is how many steps the clock of an authenticator app may be
+	// off by.
+	totpSkew = 1
+
+	recoveryCodeCount = 10
+)
+
+var (
+	errTotpNotEnabled = status.Error(codes.FailedPrecondition, "two-factor authentication is not enabled")
+	errInvalidMfaCode = status.Error(codes.InvalidArgument, "invalid code")
+)
+
+// mfaChallenge answers a correct password of user with a token for
+// VerifyMfa instead of a session.
+func (s *server) mfaChallenge(ctx context.Context, user *pb.User, client loginClient) (*pb.AuthenticateUserResponse, error) {
+	token, err := issueCode(ctx, s.db, user.Id, mfaPurpose, mfaTTL)
+	if err != nil {
+		log.Printf("Failed to create MFA token: %v", err)
+		return nil, err
+	}
+	s.recordLogin(ctx, user.Id, user.Email, client, loginMfaRequired)
+
+	return &pb.AuthenticateUserResponse{
+		MfaRequired:  true,
+		MfaToken:     token,
+		MfaExpiresAt: timestamppb.New(time.Now().Add(mfaTTL)),
+	}, nil
+}
+
+func (s *server) VerifyMfa(ctx context.Context, req *pb.VerifyMfaRequest) (*pb.AuthenticateUserResponse, error) {
+	id, err := lookupCode(ctx, s.db, mfaPurpose, req.GetMfaToken())
+	if errors.Is(err, errInvalidCode) {
+		return nil, status.Error(codes.Unauthenticated, "invalid or expired MFA token")
+	}
+	if err != nil {
+		log.Printf("Failed to check MFA token: %v", err)
+		return nil, err
+	}
+	user, err := s.readUser(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Guessing codes is throttled like guessing passwords
+	client := clientOf(ctx)
+	if err := s.reserveLoginAttempt(ctx, user.Email, client); err != nil {
+		if status.Code(err) == codes.ResourceExhausted {
+			s.recordLogin(ctx, id, user.Email, client, loginThrottled)
+		} else {
+			log.Printf("Failed to check failed logins: %v", err)
+		}
+		return nil, err
+	}
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		log.Printf("Failed to begin transaction: %v", err)
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	ok, err := checkSecondFactor(ctx, tx, id, req.GetCode())
+	if err != nil && !errors.Is(err, errTotpNotEnabled) {
+		log.Printf("Failed to check MFA code of user %d: %v", id, err)
+		return nil, err
+	}
+	if !ok {
+		s.recordLogin(ctx, id, user.Email, client, loginWrongMfaCode)
+		return nil, status.Error(codes.Unauthenticated, "invalid code")
+	}
+
+	// The token works once; a concurrent call may have used it meanwhile
+	if _, err := consumeCode(ctx, tx, id, mfaPurpose, req.GetMfaToken()); err != nil {
+		if errors.Is(err, errInvalidCode) {
+			return nil, status.Error(codes.Unauthenticated, "invalid or expired MFA token")
+		}
+		log.Printf("Failed to use MFA token: %v", err)
+		return nil, err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		log.Printf("Failed to commit transaction: %v", err)
+		return nil, err
+	}
+	return s.completeLogin(ctx, user, client)
+}
+
+func (s *server) EnrollTotp(ctx context.Context, req *pb.EnrollTotpRequest) (*pb.EnrollTotpResponse, error) {
+	id, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	user, err := s.readUser(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	secret, err := totp.NewSecret()
+	if err != nil {
+		log.Printf("Failed to create TOTP secret: %v", err)
+		return nil, err
+	}
+
+	// Enrolling again replaces a secret that was never confirmed, but not
+	// one in use
+	tag, err := s.db.Exec(ctx, `
+		INSERT INTO user_totp (user_id, secret) VALUES ($1, $2)
+		ON CONFLICT (user_id) DO UPDATE
+		SET secret = excluded.secret, last_step = NULL, created_at = now()
+		WHERE user_totp.confirmed_at IS NULL
+	`, id, secret)
+	if err != nil {
+		log.Printf("Failed to store TOTP secret of user %d: %v", id, err)
+		return nil, err
+	}
+	if tag.RowsAffected() == 0 {
+		return nil, status.Error(codes.FailedPrecondition, "two-factor authentication is already enabled")
+	}
+
+	return &pb.EnrollTotpResponse{
+		Secret:     totp.Encode(secret),
+		OtpauthUri: totp.URI(s.totpIssuer, user.Email, secret),
+	}, nil
+}
+
+func (s *server) ConfirmTotp(ctx context.Context, req *pb.ConfirmTotpRequest) (*pb.ConfirmTotpResponse, error) {
+	id, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		log.Printf("Failed to begin transaction: %v", err)
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	ok, err := checkTotp(ctx, tx, id, req.GetCode(), false)
+	if errors.Is(err, errTotpNotEnabled) {
+		return nil, status.Error(codes.FailedPrecondition, "call EnrollTotp first")
+	}
+	if err != nil {
+		log.Printf("Failed to check TOTP code of user %d: %v", id, err)
+		return nil, err
+	}
+	if !ok {
+		return nil, errInvalidMfaCode
+	}
+
+	_, err = tx.Exec(ctx, `UPDATE user_totp SET confirmed_at = now() WHERE user_id = $1`, id)
+	if err != nil {
+		log.Printf("Failed to confirm TOTP of user %d: %v", id, err)
+		return nil, err
+	}
+	if err := bumpUserVersion(ctx, tx, id); err != nil {
+		log.Printf("Failed to update user %d: %v", id, err)
+		return nil, err
+	}
+	recoveryCodes, err := newRecoveryCodes(ctx, tx, id)
+	if err != nil {
+		log.Printf("Failed to create recovery codes: %v", err)
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		log.Printf("Failed to commit transaction: %v", err)
+		return nil, err
+	}
+	return &pb.ConfirmTotpResponse{RecoveryCodes: recoveryCodes}, nil
+}
+
+func (s *server) DisableTotp(ctx context.Context, req *pb.DisableTotpRequest) (*pb.DisableTotpResponse, error) {
+	id, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		log.Printf("Failed to begin transaction: %v", err)
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	// A stolen access token alone is not enough to turn it off
+	ok, err := checkSecondFactor(ctx, tx, id, req.GetCode())
+	if errors.Is(err, errTotpNotEnabled) {
+		return nil, err
+	}
+	if err != nil {
+		log.Printf("Failed to check MFA code of user %d: %v", id, err)
+		return nil, err
+	}
+	if !ok {
+		return nil, errInvalidMfaCode
+	}
+
+	for _, stmt := range []string{
+		`DELETE FROM user_totp WHERE user_id = $1`,
+		`DELETE FROM user_recovery_codes WHERE user_id = $1`,
+	} {
+		if _, err := tx.Exec(ctx, stmt, id); err != nil {
+			log.Printf("Failed to disable TOTP of user %d: %v", id, err)
+			return nil, err
+		}
+	}
+	if err := bumpUserVersion(ctx, tx, id); err != nil {
+		log.Printf("Failed to update user %d: %v", id, err)
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		log.Printf("Failed to commit transaction: %v", err)
+		return nil, err
+	}
+	return &pb.DisableTotpResponse{}, nil
+}
+
+func (s *server) RegenerateRecoveryCodes(ctx context.Context, req *pb.RegenerateRecoveryCodesRequest) (*pb.RegenerateRecoveryCodesResponse, error) {
+	id, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		log.Printf("Failed to begin transaction: %v", err)
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	ok, err := checkTotp(ctx, tx, id, req.GetCode(), true)
+	if errors.Is(err, errTotpNotEnabled) {
+		return nil, err
+	}
+	if err != nil {
+		log.Printf("Failed to check TOTP code of user %d: %v", id, err)
+		return nil, err
+	}
+	if !ok {
+		return nil, errInvalidMfaCode
+	}
+
+	recoveryCodes, err := newRecoveryCodes(ctx, tx, id)
+	if err != nil {
+		log.Printf("Failed to create recovery codes: %v", err)
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		log.Printf("Failed to commit transaction: %v", err)
+		return nil, err
+	}
+	return &pb.RegenerateRecoveryCodesResponse{RecoveryCodes: recoveryCodes}, nil
+}
+
+// checkSecondFactor reports whether code is a current code of the
+// authenticator app of user id, or one of its recovery codes, which is then
+// used up.
+func checkSecondFactor(ctx context.Context, q querier, id int32, code string) (bool, error) {
+	if isTotpCode(code) {
+		return checkTotp(ctx, q, id, code, true)
+	}
+	var enabled bool
+	err := q.QueryRow(ctx, `
+		SELECT EXISTS(SELECT 1 FROM user_totp WHERE user_id = $1 AND confirmed_at IS NOT NULL)
+	`, id).Scan(&enabled)
+	if err != nil {
+		return false, err
+	}
+	if !enabled {
+		return false, errTotpNotEnabled
+	}
+
+	tag, err := q.Exec(ctx, `
+		DELETE FROM user_recovery_codes WHERE user_id = $1 AND hash = $2
+	`, id, hashCode(normalizeRecoveryCode(code)))
+	if err != nil {
+		return false, err
+	}
+	return tag.RowsAffected() > 0, nil
+}
+
+// checkTotp reports whether code is a current code of the confirmed or the
+// pending secret of user id. Each code is accepted once.
+func checkTotp(ctx context.Context, q querier, id int32, code string, confirmed bool) (bool, error) {
+	var secret []byte
+	var lastStep *int64
+	err := q.QueryRow(ctx, `
+		SELECT secret, last_step FROM user_totp
+		WHERE user_id = $1 AND (confirmed_at IS NOT NULL) = $2
+		FOR UPDATE
+	`, id, confirmed).Scan(&secret, &lastStep)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return false, errTotpNotEnabled
+	}
+	if err != nil {
+		return false, err
+	}
+
+	step, ok := totp.Match(secret, code, time.Now(), totpSkew)
+	if !ok || (lastStep != nil && step <= *lastStep) {
+		return false, nil
+	}
+	_, err = q.Exec(ctx, `UPDATE user_totp SET last_step = $2 WHERE user_id = $1`, id, step)
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// newRecoveryCodes replaces the recovery codes of user id and returns the
+// new ones. Only their hashes are stored.
+func newRecoveryCodes(ctx context.Context, q querier, id int32) ([]string, error) {
+	if _, err := q.Exec(ctx, `DELETE FROM user_recovery_codes WHERE user_id = $1`, id); err != nil {
+		return nil, err
+	}
+	recoveryCodes := make([]string, recoveryCodeCount)
+	for i := range recoveryCodes {
+		secret, err := newSecret(10)
+		if err != nil {
+			return nil, err
+		}
+		_, err = q.Exec(ctx, `
+			INSERT INTO user_recovery_codes (hash, user_id) VALUES ($1, $2)
+		`, hashCode(secret), id)
+		if err != nil {
+			return nil, err
+		}
+		// Groups of four are easier to copy by hand
+		recoveryCodes[i] = strings.Join([]string{secret[0:4], secret[4:8], secret[8:12], secret[12:16]}, "-")
+	}
+	return recoveryCodes, nil
+}
+
+// normalizeRecoveryCode undoes the grouping and any change of case.
+func normalizeRecoveryCode(code string) string {
+	return strings.ToUpper(strings.ReplaceAll(strings.TrimSpace(code), "-", ""))
+}
+
+func isTotpCode(code string) bool {
+	if len(code) != totp.Digits {
+		return false
+	}
+	for _, c := range code {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// bumpUserVersion records that user id changed.
+func bumpUserVersion(ctx context.Context, q querier, id int32) error {
+	_, err := q.Exec(ctx, `UPDATE users SET version = version + 1 WHERE id = $1`, id)
+	return err
+}
@@ -0,0 +1,91 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	"UserService/password"
+
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+// verifyPassword reports whether plaintext matches the stored hash of user
+// id. A matching password hashed with outdated parameters is rehashed with
+// the current ones; failing to store the new hash does not fail the login.
+// A hash in no known format never matches, so the user has to reset it.
+func (s *server) verifyPassword(ctx context.Context, id int32, plaintext string, hash []byte) (bool, error) {
+	ok, err := s.passwords.Verify(plaintext, hash)
+	if errors.Is(err, password.ErrUnknownHash) {
+		log.Printf("Unrecognised password hash for user %d; a reset is required", id)
+		return false, nil
+	}
+	if err != nil || !ok {
+		return false, err
+	}
+
+	if s.passwords.NeedsRehash(hash) {
+		rehashed, err := s.passwords.Hash(plaintext)
+		if err == nil {
+			// Leave the hash alone if the password changed meanwhile
+			_, err = s.db.Exec(ctx, `
+				UPDATE users
+				SET password_hash = $1
+				WHERE id = $2 AND password_hash = $3
+			`, rehashed, id, hash)
+		}
+		if err != nil {
+			log.Printf("Failed to rehash password of user %d: %v", id, err)
+		}
+	}
+	return true, nil
+}
+
+// hashLegacyPasswords hashes the plaintext passwords stored by versions that
+// wrote them into password_hash unhashed.
+func hashLegacyPasswords(ctx context.Context, db *pgxpool.Pool, passwords *password.Hasher) error {
+	type legacy struct {
+		id        int32
+		plaintext []byte
+	}
+
+	// Collect the rows first; hashing is slow and must not hold the cursor open
+	rows, err := db.Query(ctx, "SELECT id, password_hash FROM users")
+	if err != nil {
+		return err
+	}
+	var pending []legacy
+	for rows.Next() {
+		var l legacy
+		if err := rows.Scan(&l.id, &l.plaintext); err != nil {
+			rows.Close()
+			return err
+		}
+		if !password.IsHash(l.plaintext) {
+			pending = append(pending, l)
+		}
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	for _, l := range pending {
+		hash, err := passwords.Hash(string(l.plaintext))
+		if err != nil {
+			return err
+		}
+		// Leave the row alone if the password changed meanwhile
+		if _, err := db.Exec(ctx, `
+			UPDATE users
+			SET password_hash = $1
+			WHERE id = $2 AND password_hash = $3
+		`, hash, l.id, l.plaintext); err != nil {
+			return err
+		}
+	}
+	if len(pending) > 0 {
+		log.Printf("Hashed %d legacy plaintext passwords", len(pending))
+	}
+	return nil
+}
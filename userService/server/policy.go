@@ -0,0 +1,48 @@
+package main
+
+import (
+	pb "UserService/userserver/test"
+	"common/auth"
+)
+
+// policy says who may call each method. Signing up and logging in are open
+// to everyone.
+var policy = auth.Policy{
+	pb.UserService_RegisterUser_FullMethodName:     auth.Public,
+	pb.UserService_ActivateUser_FullMethodName:     auth.Public,
+	pb.UserService_AuthenticateUser_FullMethodName: auth.Public,
+	pb.UserService_RefreshToken_FullMethodName:     auth.Public,
+	pb.UserService_Logout_FullMethodName:           auth.Public,
+	pb.UserService_VerifyMfa_FullMethodName:        auth.Public,
+
+	pb.UserService_RequestPasswordReset_FullMethodName: auth.Public,
+	pb.UserService_ResetPassword_FullMethodName:        auth.Public,
+
+	pb.UserService_LogoutEverywhere_FullMethodName: auth.Authenticated,
+
+	pb.UserService_EnrollTotp_FullMethodName:              auth.Authenticated,
+	pb.UserService_ConfirmTotp_FullMethodName:             auth.Authenticated,
+	pb.UserService_DisableTotp_FullMethodName:             auth.Authenticated,
+	pb.UserService_RegenerateRecoveryCodes_FullMethodName: auth.Authenticated,
+
+	// Handlers let users reach their own account, and holders of the users
+	// permissions any account
+	pb.UserService_GetUser_FullMethodName:    auth.Authenticated,
+	pb.UserService_GetMe_FullMethodName:      auth.Authenticated,
+	pb.UserService_UpdateUser_FullMethodName: auth.Authenticated,
+	pb.UserService_DeleteUser_FullMethodName: auth.Authenticated,
+
+	pb.UserService_ListUsers_FullMethodName:  auth.AnyPermission(auth.PermissionUsersRead),
+	pb.UserService_UnlockUser_FullMethodName: auth.AnyPermission(auth.PermissionUsersWrite),
+
+	pb.UserService_ListRoles_FullMethodName:        roleManagers,
+	pb.UserService_CreateRole_FullMethodName:       roleManagers,
+	pb.UserService_DeleteRole_FullMethodName:       roleManagers,
+	pb.UserService_GrantPermission_FullMethodName:  roleManagers,
+	pb.UserService_RevokePermission_FullMethodName: roleManagers,
+	pb.UserService_GrantRole_FullMethodName:        roleManagers,
+	pb.UserService_RevokeRole_FullMethodName:       roleManagers,
+}
+
+// roleManagers may define roles and grant them.
+var roleManagers = auth.AnyPermission(auth.PermissionRolesManage)
@@ -0,0 +1,53 @@
+package main
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+const purgeInterval = time.Hour
+
+// purgeExpired deletes expired codes, refresh tokens and failed login counts,
+// and sessions left without refresh tokens, once now and then every
+// purgeInterval until ctx is done.
+func purgeExpired(ctx context.Context, db *pgxpool.Pool) {
+	ticker := time.NewTicker(purgeInterval)
+	defer ticker.Stop()
+
+	statements := []struct {
+		what, sql string
+		args      []interface{}
+	}{
+		{"codes", `DELETE FROM user_codes WHERE expires_at < now()`, nil},
+		{"refresh tokens", `DELETE FROM refresh_tokens WHERE expires_at < now()`, nil},
+		// Failures older than the window no longer count anyway
+		{"login throttles", `
+			DELETE FROM login_throttles
+			WHERE blocked_until < now() AND last_failure_at < now() - $1 * interval '1 second'
+		`, []interface{}{loginFailureWindow.Seconds()}},
+		// The access tokens of such sessions have expired long ago
+		{"sessions", `
+			DELETE FROM user_sessions s
+			WHERE NOT EXISTS (SELECT 1 FROM refresh_tokens t WHERE t.session_id = s.id)
+		`, nil},
+	}
+	for {
+		for _, stmt := range statements {
+			tag, err := db.Exec(ctx, stmt.sql, stmt.args...)
+			if err != nil {
+				log.Printf("Failed to purge expired %s: %v", stmt.what, err)
+			} else if n := tag.RowsAffected(); n > 0 {
+				log.Printf("Purged %d expired %s", n, stmt.what)
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,148 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	pb "UserService/userserver/test"
+
+	"github.com/jackc/pgx/v4"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// resetMailTimeout bounds sending a password reset email, which happens
+	// after the request has been answered.
+	resetMailTimeout = time.Minute
+
+	// resetMailInterval is the wait after the first reset email to an
+	// address. Each further request doubles it, and after maxResetMails
+	// the address gets no more until loginFailureWindow has passed.
+	resetMailInterval = time.Minute
+	maxResetMails     = 5
+
+	// resetQueueSize bounds the reset emails waiting to be sent. Requests
+	// beyond it are dropped rather than piling up.
+	resetQueueSize = 100
+)
+
+func (s *server) RequestPasswordReset(ctx context.Context, req *pb.RequestPasswordResetRequest) (*pb.RequestPasswordResetResponse, error) {
+	// Limit the emails sent to an address and the requests from a client,
+	// whether or not the email is registered
+	email := req.GetEmail()
+	account, address := throttleKeys(email, clientOf(ctx))
+	err := s.takeThrottles(ctx, "PASSWORD_RESET_THROTTLED", "too many password reset requests; try again later",
+		throttleRule{"reset:" + account, resetMailInterval, maxResetMails, loginFailureWindow},
+		throttleRule{"reset:" + address, loginBackoffBase, s.loginLimits.MaxAddressFailures, s.loginLimits.Lockout},
+	)
+	if err != nil {
+		if _, ok := status.FromError(err); !ok {
+			log.Printf("Failed to throttle password reset: %v", err)
+		}
+		return nil, err
+	}
+
+	// Whatever happens below, callers get the same empty answer, so they
+	// cannot find out which emails are registered
+	select {
+	case s.resetMails <- email:
+	default:
+		log.Printf("Dropped password reset request: %d already waiting", resetQueueSize)
+	}
+	return &pb.RequestPasswordResetResponse{}, nil
+}
+
+// sendPasswordResets sends the reset emails requested until ctx is done. It
+// runs on its own so the response time does not depend on whether an email
+// is sent.
+func (s *server) sendPasswordResets(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case email := <-s.resetMails:
+			s.sendPasswordReset(email)
+		}
+	}
+}
+
+// sendPasswordReset emails a reset code to the user with email, if there is
+// one.
+func (s *server) sendPasswordReset(email string) {
+	ctx, cancel := context.WithTimeout(context.Background(), resetMailTimeout)
+	defer cancel()
+
+	user := &pb.User{}
+	err := s.db.QueryRow(ctx, `SELECT id, name, email FROM users WHERE email = $1`, email).Scan(
+		&user.Id, &user.Name, &user.Email,
+	)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return
+	}
+	if err != nil {
+		log.Printf("Failed to read user: %v", err)
+		return
+	}
+
+	code, err := issueCode(ctx, s.db, user.Id, passwordResetPurpose, passwordResetTTL)
+	if err != nil {
+		log.Printf("Failed to create password reset code: %v", err)
+		return
+	}
+	s.sendPasswordResetMail(ctx, user, code)
+}
+
+func (s *server) ResetPassword(ctx context.Context, req *pb.ResetPasswordRequest) (*pb.ResetPasswordResponse, error) {
+	// Hash before taking locks; it is slow on purpose
+	hash, err := s.passwords.Hash(req.GetPassword().GetPlaintext())
+	if err != nil {
+		log.Printf("Failed to hash password: %v", err)
+		return nil, err
+	}
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		log.Printf("Failed to begin transaction: %v", err)
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	id, err := consumeCode(ctx, tx, 0, passwordResetPurpose, req.GetResetCode())
+	if errors.Is(err, errInvalidCode) {
+		return nil, status.Error(codes.InvalidArgument, "invalid or expired reset code")
+	}
+	if err != nil {
+		log.Printf("Failed to check reset code: %v", err)
+		return nil, err
+	}
+
+	_, err = tx.Exec(ctx, `
+		UPDATE users
+		SET password_hash = $1, version = version + 1
+		WHERE id = $2
+	`, hash, id)
+	if err != nil {
+		log.Printf("Failed to reset password of user %d: %v", id, err)
+		return nil, err
+	}
+
+	// Other codes mailed meanwhile are of no use any more, and whoever
+	// knew the old password is logged out
+	if err := deleteCodes(ctx, tx, id, passwordResetPurpose); err != nil {
+		log.Printf("Failed to delete reset codes: %v", err)
+		return nil, err
+	}
+	if _, err := revokeSessions(ctx, tx, id); err != nil {
+		log.Printf("Failed to revoke sessions of user %d: %v", id, err)
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		log.Printf("Failed to commit transaction: %v", err)
+		return nil, err
+	}
+	return &pb.ResetPasswordResponse{}, nil
+}
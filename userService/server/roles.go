@@ -0,0 +1,287 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+
+	pb "UserService/userserver/test"
+	"common/auth"
+	"common/broker"
+	"common/caller"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// roleColumns are the columns of a Role, in the order roleScanFields
+// expects them. They must be selected from the roles table unaliased.
+const roleColumns = `name, description,
+	ARRAY(SELECT permission FROM role_permissions WHERE role = roles.name ORDER BY permission)`
+
+func roleScanFields(role *pb.Role) []interface{} {
+	return []interface{}{&role.Name, &role.Description, &role.Permissions}
+}
+
+func readRole(ctx context.Context, q querier, name string) (*pb.Role, error) {
+	role := &pb.Role{}
+	err := q.QueryRow(ctx, `SELECT `+roleColumns+` FROM roles WHERE name = $1`, name).Scan(roleScanFields(role)...)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, status.Errorf(codes.NotFound, "role %q does not exist", name)
+	}
+	return role, err
+}
+
+// addPermissions lets role do everything permissions allow, defining
+// permissions that are new.
+func addPermissions(ctx context.Context, q querier, role string, permissions []string) error {
+	if len(permissions) == 0 {
+		return nil
+	}
+	_, err := q.Exec(ctx, `
+		INSERT INTO permissions (name)
+		SELECT unnest($1::text[])
+		ON CONFLICT (name) DO NOTHING
+	`, permissions)
+	if err != nil {
+		return err
+	}
+	_, err = q.Exec(ctx, `
+		INSERT INTO role_permissions (role, permission)
+		SELECT $1, unnest($2::text[])
+		ON CONFLICT DO NOTHING
+	`, role, permissions)
+	return err
+}
+
+func (s *server) ListRoles(ctx context.Context, req *pb.ListRolesRequest) (*pb.ListRolesResponse, error) {
+	rows, err := s.db.Query(ctx, `SELECT `+roleColumns+` FROM roles ORDER BY name`)
+	if err != nil {
+		log.Printf("Failed to list roles: %v", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	var roles []*pb.Role
+	for rows.Next() {
+		role := &pb.Role{}
+		if err := rows.Scan(roleScanFields(role)...); err != nil {
+			log.Printf("Failed to scan role: %v", err)
+			return nil, err
+		}
+		roles = append(roles, role)
+	}
+	if err := rows.Err(); err != nil {
+		log.Printf("Failed to list roles: %v", err)
+		return nil, err
+	}
+	return &pb.ListRolesResponse{Roles: roles}, nil
+}
+
+func (s *server) CreateRole(ctx context.Context, req *pb.CreateRoleRequest) (*pb.Role, error) {
+	role := req.GetRole()
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		log.Printf("Failed to begin transaction: %v", err)
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	_, err = tx.Exec(ctx, `INSERT INTO roles (name, description) VALUES ($1, $2)`, role.GetName(), role.GetDescription())
+	if err != nil {
+		return nil, err
+	}
+	if err := addPermissions(ctx, tx, role.GetName(), role.GetPermissions()); err != nil {
+		log.Printf("Failed to grant permissions to role %s: %v", role.GetName(), err)
+		return nil, err
+	}
+	created, err := readRole(ctx, tx, role.GetName())
+	if err != nil {
+		log.Printf("Failed to read role %s: %v", role.GetName(), err)
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		log.Printf("Failed to commit transaction: %v", err)
+		return nil, err
+	}
+	return created, nil
+}
+
+func (s *server) DeleteRole(ctx context.Context, req *pb.DeleteRoleRequest) (*pb.DeleteRoleResponse, error) {
+	// Without admins nobody could grant roles any more
+	if req.GetName() == auth.RoleAdmin {
+		return nil, status.Error(codes.FailedPrecondition, "the admin role cannot be deleted")
+	}
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		log.Printf("Failed to begin transaction: %v", err)
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	// The users holding the role change with it
+	_, err = tx.Exec(ctx, `
+		UPDATE users SET version = version + 1
+		WHERE id IN (SELECT user_id FROM user_roles WHERE role = $1)
+	`, req.GetName())
+	if err != nil {
+		log.Printf("Failed to update holders of role %s: %v", req.GetName(), err)
+		return nil, err
+	}
+
+	tag, err := tx.Exec(ctx, `DELETE FROM roles WHERE name = $1`, req.GetName())
+	if err != nil {
+		log.Printf("Failed to delete role %s: %v", req.GetName(), err)
+		return nil, err
+	}
+	if tag.RowsAffected() == 0 {
+		return nil, status.Errorf(codes.NotFound, "role %q does not exist", req.GetName())
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		log.Printf("Failed to commit transaction: %v", err)
+		return nil, err
+	}
+	return &pb.DeleteRoleResponse{Success: true}, nil
+}
+
+func (s *server) GrantPermission(ctx context.Context, req *pb.GrantPermissionRequest) (*pb.Role, error) {
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		log.Printf("Failed to begin transaction: %v", err)
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err := readRole(ctx, tx, req.GetRole()); err != nil {
+		return nil, err
+	}
+	if err := addPermissions(ctx, tx, req.GetRole(), []string{req.GetPermission()}); err != nil {
+		log.Printf("Failed to grant permission to role %s: %v", req.GetRole(), err)
+		return nil, err
+	}
+	role, err := readRole(ctx, tx, req.GetRole())
+	if err != nil {
+		log.Printf("Failed to read role %s: %v", req.GetRole(), err)
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		log.Printf("Failed to commit transaction: %v", err)
+		return nil, err
+	}
+	return role, nil
+}
+
+func (s *server) RevokePermission(ctx context.Context, req *pb.RevokePermissionRequest) (*pb.Role, error) {
+	// Without it nobody could grant roles any more
+	if req.GetRole() == auth.RoleAdmin && req.GetPermission() == auth.PermissionRolesManage {
+		return nil, status.Error(codes.FailedPrecondition, "admins always manage roles")
+	}
+
+	_, err := s.db.Exec(ctx, `
+		DELETE FROM role_permissions
+		WHERE role = $1 AND permission = $2
+	`, req.GetRole(), req.GetPermission())
+	if err != nil {
+		log.Printf("Failed to revoke permission of role %s: %v", req.GetRole(), err)
+		return nil, err
+	}
+	return readRole(ctx, s.db, req.GetRole())
+}
+
+func (s *server) GrantRole(ctx context.Context, req *pb.GrantRoleRequest) (*pb.User, error) {
+	return s.changeRole(ctx, req.GetUserId(), req.GetRole(), `
+		INSERT INTO user_roles (user_id, role, granted_by)
+		VALUES ($1, $2, $3)
+		ON CONFLICT DO NOTHING
+	`, caller.ID(ctx))
+}
+
+func (s *server) RevokeRole(ctx context.Context, req *pb.RevokeRoleRequest) (*pb.User, error) {
+	// Admins cannot lock themselves out
+	if req.GetRole() == auth.RoleAdmin && caller.ID(ctx) == fmt.Sprint(req.GetUserId()) {
+		return nil, status.Error(codes.FailedPrecondition, "cannot revoke your own admin role")
+	}
+	return s.changeRole(ctx, req.GetUserId(), req.GetRole(), `
+		DELETE FROM user_roles
+		WHERE user_id = $1 AND role = $2
+	`)
+}
+
+// changeRole runs stmt, which grants or revokes role of user id, and bumps
+// the version of the user if it changed anything. Tokens issued from then on
+// reflect the change.
+func (s *server) changeRole(ctx context.Context, id int32, role, stmt string, args ...interface{}) (*pb.User, error) {
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		log.Printf("Failed to begin transaction: %v", err)
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	// Lock the user so its version moves in step with its roles
+	var version int32
+	err = tx.QueryRow(ctx, `SELECT version FROM users WHERE id = $1 FOR UPDATE`, id).Scan(&version)
+	if err != nil {
+		if !errors.Is(err, pgx.ErrNoRows) {
+			log.Printf("Failed to read user %d: %v", id, err)
+		}
+		return nil, err
+	}
+	if _, err := readRole(ctx, tx, role); err != nil {
+		return nil, err
+	}
+
+	tag, err := tx.Exec(ctx, stmt, append([]interface{}{id, role}, args...)...)
+	if err != nil {
+		log.Printf("Failed to change role %s of user %d: %v", role, id, err)
+		return nil, err
+	}
+	if tag.RowsAffected() > 0 {
+		if err := bumpUserVersion(ctx, tx, id); err != nil {
+			log.Printf("Failed to update user %d: %v", id, err)
+			return nil, err
+		}
+	}
+
+	user := &pb.User{}
+	err = tx.QueryRow(ctx, `SELECT `+userColumns+` FROM users WHERE id = $1`, id).Scan(userScanFields(user)...)
+	if err != nil {
+		log.Printf("Failed to read user %d: %v", id, err)
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		log.Printf("Failed to commit transaction: %v", err)
+		return nil, err
+	}
+	if tag.RowsAffected() > 0 {
+		broker.PublishEvent(ctx, s.events, userEventsExchange, userUpdated, user)
+	}
+	return user, nil
+}
+
+// grantBootstrapAdmin makes the user with email an admin, so that a new
+// installation has someone who can grant roles. Nothing happens if nobody
+// has registered with email yet.
+func grantBootstrapAdmin(ctx context.Context, db *pgxpool.Pool, email string) error {
+	tag, err := db.Exec(ctx, `
+		INSERT INTO user_roles (user_id, role, granted_by)
+		SELECT id, $2, 'ADMIN_EMAIL' FROM users WHERE email = $1
+		ON CONFLICT DO NOTHING
+	`, email, auth.RoleAdmin)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() > 0 {
+		log.Printf("Granted the admin role to %s", email)
+	}
+	return nil
+}
@@ -0,0 +1,162 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+// schema holds the statements that bring an existing bookstore database up
+// to date with this server. Every statement must be safe to run on each
+// start, so they only ever create what is missing.
+var schema = []string{
+	`CREATE TABLE IF NOT EXISTS users (
+		id            SERIAL PRIMARY KEY,
+		created_at    TIMESTAMPTZ NOT NULL DEFAULT now(),
+		name          TEXT NOT NULL,
+		email         TEXT NOT NULL,
+		password_hash BYTEA NOT NULL,
+		activated     BOOLEAN NOT NULL DEFAULT false,
+		version       INTEGER NOT NULL DEFAULT 1,
+		CONSTRAINT users_email_key UNIQUE (email)
+	)`,
+	`CREATE TABLE IF NOT EXISTS roles (
+		name        TEXT PRIMARY KEY,
+		description TEXT NOT NULL DEFAULT '',
+		created_at  TIMESTAMPTZ NOT NULL DEFAULT now()
+	)`,
+	`CREATE TABLE IF NOT EXISTS permissions (
+		name        TEXT PRIMARY KEY,
+		description TEXT NOT NULL DEFAULT '',
+		created_at  TIMESTAMPTZ NOT NULL DEFAULT now()
+	)`,
+	`CREATE TABLE IF NOT EXISTS role_permissions (
+		role       TEXT NOT NULL REFERENCES roles (name) ON DELETE CASCADE,
+		permission TEXT NOT NULL REFERENCES permissions (name) ON DELETE CASCADE,
+		PRIMARY KEY (role, permission)
+	)`,
+	`CREATE TABLE IF NOT EXISTS user_roles (
+		user_id    INTEGER NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+		role       TEXT NOT NULL REFERENCES roles (name) ON DELETE CASCADE,
+		granted_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+		granted_by TEXT NOT NULL DEFAULT '',
+		PRIMARY KEY (user_id, role)
+	)`,
+	`CREATE INDEX IF NOT EXISTS user_roles_role_idx ON user_roles (role)`,
+	// The roles and permissions the services rely on
+	`INSERT INTO permissions (name, description) VALUES
+		('catalog.write', 'Create, update and delete books and comics'),
+		('users.read', 'Read any user'),
+		('users.write', 'Update and delete any user'),
+		('roles.manage', 'Define roles and grant them to users')
+	ON CONFLICT (name) DO NOTHING`,
+	`INSERT INTO roles (name, description) VALUES
+		('admin', 'Full access'),
+		('catalog_editor', 'Maintains the catalog')
+	ON CONFLICT (name) DO NOTHING`,
+	`INSERT INTO role_permissions (role, permission) VALUES
+		('admin', 'catalog.write'),
+		('admin', 'users.read'),
+		('admin', 'users.write'),
+		('admin', 'roles.manage'),
+		('catalog_editor', 'catalog.write')
+	ON CONFLICT DO NOTHING`,
+	// Users used to have a comma-separated roles column; move its contents
+	// to user_roles once
+	`DO $$
+	BEGIN
+		IF EXISTS (
+			SELECT 1 FROM information_schema.columns
+			WHERE table_schema = current_schema() AND table_name = 'users' AND column_name = 'roles'
+		) THEN
+			INSERT INTO roles (name)
+			SELECT DISTINCT trim(role)
+			FROM users, unnest(string_to_array(roles, ',')) AS role
+			WHERE trim(role) <> ''
+			ON CONFLICT (name) DO NOTHING;
+
+			INSERT INTO user_roles (user_id, role)
+			SELECT DISTINCT id, trim(role)
+			FROM users, unnest(string_to_array(roles, ',')) AS role
+			WHERE trim(role) <> ''
+			ON CONFLICT DO NOTHING;
+
+			ALTER TABLE users DROP COLUMN roles;
+		END IF;
+	END $$`,
+	// Single-use codes such as activation codes. Only a hash of each code
+	// is stored, so a leaked table cannot be used to take over accounts.
+	`CREATE TABLE IF NOT EXISTS user_codes (
+		hash       BYTEA PRIMARY KEY,
+		user_id    INTEGER NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+		purpose    TEXT NOT NULL,
+		expires_at TIMESTAMPTZ NOT NULL,
+		created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+	)`,
+	`CREATE INDEX IF NOT EXISTS user_codes_user_id_idx ON user_codes (user_id, purpose)`,
+	// Failed logins per email and per client address, which make further
+	// attempts wait
+	`CREATE TABLE IF NOT EXISTS login_throttles (
+		key             TEXT PRIMARY KEY,
+		failures        INTEGER NOT NULL,
+		last_failure_at TIMESTAMPTZ NOT NULL,
+		blocked_until   TIMESTAMPTZ NOT NULL
+	)`,
+	`CREATE TABLE IF NOT EXISTS login_history (
+		id         BIGSERIAL PRIMARY KEY,
+		user_id    INTEGER REFERENCES users (id) ON DELETE SET NULL,
+		email      TEXT NOT NULL,
+		ip         TEXT NOT NULL,
+		user_agent TEXT NOT NULL,
+		success    BOOLEAN NOT NULL,
+		reason     TEXT NOT NULL,
+		created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+	)`,
+	`CREATE INDEX IF NOT EXISTS login_history_user_id_idx ON login_history (user_id, created_at)`,
+	`CREATE INDEX IF NOT EXISTS login_history_ip_idx ON login_history (ip, created_at)`,
+	// Authenticator app secrets. The secret has to be kept as is to check
+	// codes; confirmed_at is set once the user has shown the app works.
+	// last_step stops codes from being used twice.
+	`CREATE TABLE IF NOT EXISTS user_totp (
+		user_id      INTEGER PRIMARY KEY REFERENCES users (id) ON DELETE CASCADE,
+		secret       BYTEA NOT NULL,
+		confirmed_at TIMESTAMPTZ,
+		last_step    BIGINT,
+		created_at   TIMESTAMPTZ NOT NULL DEFAULT now()
+	)`,
+	`CREATE TABLE IF NOT EXISTS user_recovery_codes (
+		hash       BYTEA PRIMARY KEY,
+		user_id    INTEGER NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+		created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+	)`,
+	`CREATE INDEX IF NOT EXISTS user_recovery_codes_user_id_idx ON user_recovery_codes (user_id)`,
+	// A session is one login. Its access tokens name it, so revoking it
+	// logs the user out. The other services read revoked_at directly.
+	`CREATE TABLE IF NOT EXISTS user_sessions (
+		id         TEXT PRIMARY KEY,
+		user_id    INTEGER NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+		created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+		revoked_at TIMESTAMPTZ
+	)`,
+	`CREATE INDEX IF NOT EXISTS user_sessions_user_id_idx ON user_sessions (user_id)`,
+	// Refresh tokens are rotated on every use. Used tokens are kept until
+	// they expire so that replaying one can be detected.
+	`CREATE TABLE IF NOT EXISTS refresh_tokens (
+		hash       BYTEA PRIMARY KEY,
+		session_id TEXT NOT NULL REFERENCES user_sessions (id) ON DELETE CASCADE,
+		expires_at TIMESTAMPTZ NOT NULL,
+		used_at    TIMESTAMPTZ,
+		created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+	)`,
+	`CREATE INDEX IF NOT EXISTS refresh_tokens_session_id_idx ON refresh_tokens (session_id)`,
+}
+
+func migrate(ctx context.Context, db *pgxpool.Pool) error {
+	for _, stmt := range schema {
+		if _, err := db.Exec(ctx, stmt); err != nil {
+			return fmt.Errorf("apply schema: %w", err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,218 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	pb "UserService/userserver/test"
+	"common/auth"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v4"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// refreshTokenTTL is how long a refresh token can be used. Every refresh
+// hands out a new one, so sessions in use do not expire.
+const refreshTokenTTL = 30 * 24 * time.Hour
+
+// errInvalidRefreshToken is returned for refresh tokens that are unknown,
+// expired, used or of a session that has ended.
+var errInvalidRefreshToken = status.Error(codes.Unauthenticated, "invalid or expired refresh token")
+
+// startSession creates a session for user id and returns it with its first
+// refresh token. q should be a transaction: a session without refresh
+// tokens is purged.
+func startSession(ctx context.Context, q querier, id int32) (string, string, time.Time, error) {
+	session := uuid.NewString()
+	_, err := q.Exec(ctx, `INSERT INTO user_sessions (id, user_id) VALUES ($1, $2)`, session, id)
+	if err != nil {
+		return "", "", time.Time{}, err
+	}
+	token, expiresAt, err := issueRefreshToken(ctx, q, session)
+	if err != nil {
+		return "", "", time.Time{}, err
+	}
+	return session, token, expiresAt, nil
+}
+
+// issueRefreshToken stores a new refresh token of session and returns it
+// with its expiry. Only its hash is kept.
+func issueRefreshToken(ctx context.Context, q querier, session string) (string, time.Time, error) {
+	token, err := newSecret(32)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	expiresAt := time.Now().Add(refreshTokenTTL)
+	_, err = q.Exec(ctx, `
+		INSERT INTO refresh_tokens (hash, session_id, expires_at)
+		VALUES ($1, $2, $3)
+	`, hashCode(token), session, expiresAt)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	return token, expiresAt, nil
+}
+
+// issueAccessToken returns an access token for user id in session carrying
+// the user's current roles and permissions, and when it expires.
+func (s *server) issueAccessToken(ctx context.Context, q querier, id int32, session string) (string, time.Time, error) {
+	var roles, permissions []string
+	err := q.QueryRow(ctx, `
+		SELECT
+			ARRAY(SELECT role FROM user_roles WHERE user_id = $1 ORDER BY role),
+			ARRAY(
+				SELECT DISTINCT rp.permission
+				FROM user_roles ur
+				JOIN role_permissions rp ON rp.role = ur.role
+				WHERE ur.user_id = $1
+				ORDER BY rp.permission
+			)
+	`, id).Scan(&roles, &permissions)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	return s.tokens.Issue(id, session, roles, permissions)
+}
+
+func (s *server) RefreshToken(ctx context.Context, req *pb.RefreshTokenRequest) (*pb.RefreshTokenResponse, error) {
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		log.Printf("Failed to begin transaction: %v", err)
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	// Lock the token so that it cannot be used twice concurrently
+	var session string
+	var id int32
+	var used, expired, revoked bool
+	err = tx.QueryRow(ctx, `
+		SELECT t.session_id, s.user_id, t.used_at IS NOT NULL, t.expires_at <= now(), s.revoked_at IS NOT NULL
+		FROM refresh_tokens t
+		JOIN user_sessions s ON s.id = t.session_id
+		WHERE t.hash = $1
+		FOR UPDATE OF t, s
+	`, hashCode(req.GetRefreshToken())).Scan(&session, &id, &used, &expired, &revoked)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, errInvalidRefreshToken
+	}
+	if err != nil {
+		log.Printf("Failed to read refresh token: %v", err)
+		return nil, err
+	}
+
+	// A used token coming back means it was copied; whoever holds the
+	// newer tokens of the session may not be the user, so end it
+	if used && !revoked {
+		log.Printf("Refresh token of session %s was reused; revoking the session", session)
+		if err := revokeSession(ctx, tx, session); err != nil {
+			log.Printf("Failed to revoke session %s: %v", session, err)
+			return nil, err
+		}
+		if err := tx.Commit(ctx); err != nil {
+			log.Printf("Failed to commit transaction: %v", err)
+			return nil, err
+		}
+		return nil, errInvalidRefreshToken
+	}
+	if used || expired || revoked {
+		return nil, errInvalidRefreshToken
+	}
+
+	_, err = tx.Exec(ctx, `UPDATE refresh_tokens SET used_at = now() WHERE hash = $1`, hashCode(req.GetRefreshToken()))
+	if err != nil {
+		log.Printf("Failed to use refresh token: %v", err)
+		return nil, err
+	}
+	refreshToken, refreshExpiresAt, err := issueRefreshToken(ctx, tx, session)
+	if err != nil {
+		log.Printf("Failed to issue refresh token: %v", err)
+		return nil, err
+	}
+
+	// Pick up role changes made since the last token
+	token, expiresAt, err := s.issueAccessToken(ctx, tx, id, session)
+	if err != nil {
+		log.Printf("Failed to issue token: %v", err)
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		log.Printf("Failed to commit transaction: %v", err)
+		return nil, err
+	}
+	return &pb.RefreshTokenResponse{
+		Token:                 token,
+		ExpiresAt:             timestamppb.New(expiresAt),
+		RefreshToken:          refreshToken,
+		RefreshTokenExpiresAt: timestamppb.New(refreshExpiresAt),
+	}, nil
+}
+
+func (s *server) Logout(ctx context.Context, req *pb.LogoutRequest) (*pb.LogoutResponse, error) {
+	// Logging out of a session that has already ended succeeds
+	tag, err := s.db.Exec(ctx, `
+		UPDATE user_sessions s
+		SET revoked_at = coalesce(s.revoked_at, now())
+		FROM refresh_tokens t
+		WHERE t.hash = $1 AND s.id = t.session_id
+	`, hashCode(req.GetRefreshToken()))
+	if err != nil {
+		log.Printf("Failed to revoke session: %v", err)
+		return nil, err
+	}
+	if tag.RowsAffected() == 0 {
+		return nil, errInvalidRefreshToken
+	}
+	return &pb.LogoutResponse{}, nil
+}
+
+func (s *server) LogoutEverywhere(ctx context.Context, req *pb.LogoutEverywhereRequest) (*pb.LogoutEverywhereResponse, error) {
+	id, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	n, err := revokeSessions(ctx, s.db, id)
+	if err != nil {
+		log.Printf("Failed to revoke sessions of user %d: %v", id, err)
+		return nil, err
+	}
+	return &pb.LogoutEverywhereResponse{SessionsRevoked: int32(n)}, nil
+}
+
+// revokeSession ends session.
+func revokeSession(ctx context.Context, q querier, session string) error {
+	_, err := q.Exec(ctx, `
+		UPDATE user_sessions SET revoked_at = now()
+		WHERE id = $1 AND revoked_at IS NULL
+	`, session)
+	return err
+}
+
+// revokeSessions ends every session of user id and returns how many there
+// were.
+func revokeSessions(ctx context.Context, q querier, id int32) (int64, error) {
+	tag, err := q.Exec(ctx, `
+		UPDATE user_sessions SET revoked_at = now()
+		WHERE user_id = $1 AND revoked_at IS NULL
+	`, id)
+	if err != nil {
+		return 0, err
+	}
+	return tag.RowsAffected(), nil
+}
+
+// callerID returns the id of the user whose token the request carries.
+func callerID(ctx context.Context) (int32, error) {
+	claims, ok := auth.FromContext(ctx)
+	if !ok {
+		return 0, status.Error(codes.Unauthenticated, "missing bearer token")
+	}
+	return claims.UserID()
+}
@@ -0,0 +1,270 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"math"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	pb "UserService/userserver/test"
+
+	"github.com/jackc/pgx/v4"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+const (
+	// loginBackoffBase is the wait after the first failed login. Each
+	// further failure doubles it, up to the lockout duration.
+	loginBackoffBase = time.Second
+
+	// loginFailureWindow is how long a failed login counts against an
+	// account or address.
+	loginFailureWindow = time.Hour
+)
+
+// Reasons recorded in the login history.
+const (
+	loginSucceeded     = "success"
+	loginUnknownEmail  = "unknown_email"
+	loginWrongPassword = "wrong_password"
+	loginNotActivated  = "not_activated"
+	loginThrottled     = "throttled"
+	loginMfaRequired   = "mfa_required"
+	loginWrongMfaCode  = "wrong_mfa_code"
+)
+
+// loginLimits configures how failed logins slow down further attempts.
+type loginLimits struct {
+	// MaxAccountFailures and MaxAddressFailures are the failed logins
+	// after which an email or a client address is locked out.
+	MaxAccountFailures int
+	MaxAddressFailures int
+	Lockout            time.Duration
+}
+
+// loginLimitsFromEnv reads LOGIN_MAX_FAILURES, LOGIN_MAX_IP_FAILURES and
+// LOGIN_LOCKOUT (a Go duration). Accounts lock after 10 failures and
+// addresses after 100, for 15 minutes.
+func loginLimitsFromEnv() (loginLimits, error) {
+	limits := loginLimits{MaxAccountFailures: 10, MaxAddressFailures: 100, Lockout: 15 * time.Minute}
+	for name, dst := range map[string]*int{
+		"LOGIN_MAX_FAILURES":    &limits.MaxAccountFailures,
+		"LOGIN_MAX_IP_FAILURES": &limits.MaxAddressFailures,
+	} {
+		if v := os.Getenv(name); v != "" {
+			n, err := strconv.Atoi(v)
+			if err != nil || n < 1 {
+				return limits, fmt.Errorf("invalid %s %q", name, v)
+			}
+			*dst = n
+		}
+	}
+	if v := os.Getenv("LOGIN_LOCKOUT"); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil || d <= 0 {
+			return limits, fmt.Errorf("invalid LOGIN_LOCKOUT %q", v)
+		}
+		limits.Lockout = d
+	}
+	return limits, nil
+}
+
+// loginClient describes where a login comes from.
+type loginClient struct {
+	IP        string
+	UserAgent string
+}
+
+// clientOf returns the client making the request. Behind the gateway the
+// address is the one the gateway appended to X-Forwarded-For; the header is
+// only trusted from local peers, so callers cannot pick their address.
+func clientOf(ctx context.Context) loginClient {
+	var client loginClient
+	if p, ok := peer.FromContext(ctx); ok {
+		if host, _, err := net.SplitHostPort(p.Addr.String()); err == nil {
+			client.IP = host
+		}
+	}
+	md, _ := metadata.FromIncomingContext(ctx)
+	if ip := net.ParseIP(client.IP); ip != nil && ip.IsLoopback() {
+		if forwarded := md.Get("x-forwarded-for"); len(forwarded) > 0 {
+			hops := strings.Split(forwarded[len(forwarded)-1], ",")
+			client.IP = strings.TrimSpace(hops[len(hops)-1])
+		}
+	}
+	for _, key := range []string{"grpcgateway-user-agent", "user-agent"} {
+		if values := md.Get(key); len(values) > 0 {
+			client.UserAgent = values[0]
+			break
+		}
+	}
+	return client
+}
+
+// throttleKeys returns the keys failed logins are counted under: the email,
+// whether or not it is registered, and the client address.
+func throttleKeys(email string, client loginClient) (account, address string) {
+	return "email:" + strings.ToLower(email), "ip:" + client.IP
+}
+
+// reserveLoginAttempt counts a login attempt for email from client as failed
+// before the credentials are checked, and fails with RESOURCE_EXHAUSTED,
+// saying how long to wait, while either key is blocked. Counting first means
+// concurrent guesses cannot all slip through before the first failure is
+// recorded. Attempts that turn out to be correct are handed back with
+// releaseLoginAttempt.
+func (s *server) reserveLoginAttempt(ctx context.Context, email string, client loginClient) error {
+	account, address := throttleKeys(email, client)
+	return s.takeThrottles(ctx, "LOGIN_THROTTLED", "too many failed logins; try again later",
+		throttleRule{account, loginBackoffBase, s.loginLimits.MaxAccountFailures, s.loginLimits.Lockout},
+		throttleRule{address, loginBackoffBase, s.loginLimits.MaxAddressFailures, s.loginLimits.Lockout},
+	)
+}
+
+// throttleRule limits the attempts counted under key. The first attempt in
+// loginFailureWindow blocks the next one for base, every further attempt
+// blocks for twice as long as the one before, and the max-th attempt locks
+// the key for lockout.
+type throttleRule struct {
+	key     string
+	base    time.Duration
+	max     int
+	lockout time.Duration
+}
+
+// takeThrottles counts an attempt against every rule, or against none of
+// them and fails with RESOURCE_EXHAUSTED for reason if any key is blocked.
+func (s *server) takeThrottles(ctx context.Context, reason, message string, rules ...throttleRule) error {
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	for _, r := range rules {
+		// Blocked keys are left alone, so the upsert returns no row
+		var failures int
+		err := tx.QueryRow(ctx, `
+			INSERT INTO login_throttles AS t (key, failures, last_failure_at, blocked_until)
+			VALUES ($1, 1, now(), now() + CASE WHEN $3::int <= 1 THEN $4::float8 ELSE $2::float8 END * interval '1 second')
+			ON CONFLICT (key) DO UPDATE SET
+				failures = CASE WHEN t.last_failure_at < now() - $5::float8 * interval '1 second' THEN 1 ELSE t.failures + 1 END,
+				last_failure_at = now(),
+				blocked_until = now() + CASE
+					WHEN t.last_failure_at < now() - $5::float8 * interval '1 second'
+						THEN $2::float8
+					WHEN t.failures + 1 >= $3::int
+						THEN $4::float8
+					ELSE least($2::float8 * power(2, t.failures), $4::float8)
+				END * interval '1 second'
+			WHERE t.blocked_until <= now()
+			RETURNING failures
+		`, r.key, r.base.Seconds(), r.max, r.lockout.Seconds(), loginFailureWindow.Seconds()).Scan(&failures)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return throttledError(ctx, tx, r.key, reason, message)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return tx.Commit(ctx)
+}
+
+// throttledError returns the RESOURCE_EXHAUSTED error for the blocked key.
+func throttledError(ctx context.Context, q querier, key, reason, message string) error {
+	var wait float64
+	err := q.QueryRow(ctx, `
+		SELECT greatest(extract(epoch FROM blocked_until - now()), 0)
+		FROM login_throttles
+		WHERE key = $1
+	`, key).Scan(&wait)
+	if err != nil {
+		return err
+	}
+
+	retryAfter := time.Duration(math.Ceil(wait)) * time.Second
+	if retryAfter < time.Second {
+		retryAfter = time.Second
+	}
+	st, err := status.New(codes.ResourceExhausted, message).WithDetails(
+		&errdetails.ErrorInfo{
+			Reason:   reason,
+			Domain:   errorDomain,
+			Metadata: map[string]string{"retry_after_seconds": strconv.Itoa(int(retryAfter.Seconds()))},
+		},
+		&errdetails.RetryInfo{RetryDelay: durationpb.New(retryAfter)},
+	)
+	if err != nil {
+		return err
+	}
+	return st.Err()
+}
+
+// releaseLoginAttempt hands back an attempt reserved for email from client
+// whose credentials were correct. Once the user is fully logged in, complete
+// also forgets the failed logins of the account; those of the client address
+// still count, so that logging into an account of one's own does not allow
+// guessing more passwords.
+func (s *server) releaseLoginAttempt(ctx context.Context, email string, client loginClient, complete bool) error {
+	account, address := throttleKeys(email, client)
+	keys := []string{address}
+	if complete {
+		if err := s.clearLoginFailures(ctx, email); err != nil {
+			return err
+		}
+	} else {
+		keys = append(keys, account)
+	}
+	_, err := s.db.Exec(ctx, `
+		UPDATE login_throttles
+		SET failures = greatest(failures - 1, 0), blocked_until = least(blocked_until, now())
+		WHERE key = ANY($1)
+	`, keys)
+	return err
+}
+
+// clearLoginFailures forgets the failed logins for email.
+func (s *server) clearLoginFailures(ctx context.Context, email string) error {
+	account, _ := throttleKeys(email, loginClient{})
+	_, err := s.db.Exec(ctx, `DELETE FROM login_throttles WHERE key = $1`, account)
+	return err
+}
+
+func (s *server) UnlockUser(ctx context.Context, req *pb.UnlockUserRequest) (*pb.User, error) {
+	user, err := s.readUser(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	if err := s.clearLoginFailures(ctx, user.GetEmail()); err != nil {
+		log.Printf("Failed to unlock user %d: %v", user.GetId(), err)
+		return nil, err
+	}
+	return user, nil
+}
+
+// recordLogin adds a login attempt to the history. id is 0 for unknown
+// emails.
+func (s *server) recordLogin(ctx context.Context, id int32, email string, client loginClient, reason string) {
+	var userID interface{}
+	if id != 0 {
+		userID = id
+	}
+	_, err := s.db.Exec(ctx, `
+		INSERT INTO login_history (user_id, email, ip, user_agent, success, reason)
+		VALUES ($1, $2, $3, $4, $5, $6)
+	`, userID, email, client.IP, client.UserAgent, reason == loginSucceeded, reason)
+	if err != nil {
+		log.Printf("Failed to record login: %v", err)
+	}
+}
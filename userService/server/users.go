@@ -0,0 +1,341 @@
+package main
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+
+	pb "UserService/userserver/test"
+	"common/auth"
+	"common/broker"
+	"common/etag"
+	"common/fieldmask"
+	"common/pagetoken"
+
+	"github.com/jackc/pgx/v4"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultPageSize = 20
+	maxPageSize     = 100
+)
+
+// userColumns are the columns of a User, in the order userScanFields
+// expects them. They must be selected from the users table unaliased.
+const userColumns = `id, name, email, activated, version,
+	ARRAY(SELECT role FROM user_roles WHERE user_id = users.id ORDER BY role),
+	EXISTS(SELECT 1 FROM user_totp WHERE user_id = users.id AND confirmed_at IS NOT NULL)`
+
+// updatableUserColumns are the columns UpdateUser can write, named like
+// the User fields.
+var updatableUserColumns = []string{"name", "email"}
+
+// managedUserFields are User fields other RPCs change. UpdateUser ignores
+// them, so a user can be sent back as read.
+var managedUserFields = []string{"id", "version", "activated", "roles", "totp_enabled"}
+
+// userScanFields returns the destinations for scanning userColumns into
+// user.
+func userScanFields(user *pb.User) []interface{} {
+	return []interface{}{&user.Id, &user.Name, &user.Email, &user.Activated, &user.Version, &user.Roles, &user.TotpEnabled}
+}
+
+// authorizeUser allows the request if it comes from user id or a caller
+// holding permission.
+func authorizeUser(ctx context.Context, id int32, permission string) error {
+	claims, ok := auth.FromContext(ctx)
+	if !ok {
+		return status.Error(codes.Unauthenticated, "missing bearer token")
+	}
+	if claims.HasPermission(permission) {
+		return nil
+	}
+	if caller, err := claims.UserID(); err != nil || caller != id {
+		return status.Error(codes.PermissionDenied, "cannot access other users")
+	}
+	return nil
+}
+
+func (s *server) GetUser(ctx context.Context, req *pb.GetUserRequest) (*pb.User, error) {
+	if err := authorizeUser(ctx, req.GetId(), auth.PermissionUsersRead); err != nil {
+		return nil, err
+	}
+	return s.readUser(ctx, req.GetId())
+}
+
+func (s *server) GetMe(ctx context.Context, req *pb.GetMeRequest) (*pb.User, error) {
+	id, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.readUser(ctx, id)
+}
+
+func (s *server) readUser(ctx context.Context, id int32) (*pb.User, error) {
+	// Prepare the SQL statement
+	stmt := `SELECT ` + userColumns + ` FROM users WHERE id = $1`
+
+	// Execute the SQL statement
+	user := &pb.User{}
+	err := s.db.QueryRow(ctx, stmt, id).Scan(userScanFields(user)...)
+	if err != nil {
+		if !errors.Is(err, pgx.ErrNoRows) {
+			log.Printf("Failed to read user %d: %v", id, err)
+		}
+		return nil, err
+	}
+	return user, nil
+}
+
+func (s *server) UpdateUser(ctx context.Context, req *pb.UpdateUserRequest) (*pb.User, error) {
+	id := req.GetId()
+	if err := authorizeUser(ctx, id, auth.PermissionUsersWrite); err != nil {
+		return nil, err
+	}
+	updatedUser := req.GetUser()
+
+	// The update must be based on the current version of the user
+	expectedVersion, err := etag.Expected(ctx, updatedUser.GetVersion())
+	if err != nil {
+		return nil, err
+	}
+
+	// Only the columns selected by the update mask are written. Fields
+	// managed by other RPCs may be sent back as read, but are left alone
+	columns, err := fieldmask.Paths(req.GetUpdateMask(), updatableUserColumns, managedUserFields...)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		log.Printf("Failed to begin transaction: %v", err)
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	// Lock the current row to compare versions
+	var currentVersion int32
+	var currentEmail string
+	err = tx.QueryRow(ctx, `SELECT version, email FROM users WHERE id = $1 FOR UPDATE`, id).Scan(&currentVersion, &currentEmail)
+	if err != nil {
+		if !errors.Is(err, pgx.ErrNoRows) {
+			log.Printf("Failed to read user %d: %v", id, err)
+		}
+		return nil, err
+	}
+	if currentVersion != expectedVersion {
+		return nil, etag.MismatchError(errorDomain, fmt.Sprintf("user %d", id), currentVersion)
+	}
+
+	var assignments []string
+	var args []interface{}
+	emailChanged := false
+	for _, column := range columns {
+		switch column {
+		case "name":
+			args = append(args, updatedUser.GetName())
+		case "email":
+			args = append(args, updatedUser.GetEmail())
+			emailChanged = !strings.EqualFold(updatedUser.GetEmail(), currentEmail)
+		}
+		assignments = append(assignments, fmt.Sprintf("%s = $%d", column, len(args)))
+	}
+	// A new address has to be confirmed like the first one was
+	if emailChanged {
+		assignments = append(assignments, "activated = false")
+	}
+	args = append(args, id)
+
+	// Prepare the SQL statement
+	stmt := fmt.Sprintf(`
+		UPDATE users
+		SET %s, version = version + 1
+		WHERE id = $%d
+		RETURNING %s
+	`, strings.Join(assignments, ", "), len(args), userColumns)
+
+	// Execute the SQL statement, reading back the whole updated row
+	user := &pb.User{}
+	err = tx.QueryRow(ctx, stmt, args...).Scan(userScanFields(user)...)
+	if err != nil {
+		log.Printf("Failed to update user %d: %v", id, err)
+		return nil, err
+	}
+
+	// Codes sent to the old address must not activate the new one, and the
+	// account stays logged out until the new address is confirmed
+	var code string
+	if emailChanged {
+		if _, err := revokeSessions(ctx, tx, id); err != nil {
+			log.Printf("Failed to revoke sessions of user %d: %v", id, err)
+			return nil, err
+		}
+		if err := deleteCodes(ctx, tx, id, activationPurpose); err != nil {
+			log.Printf("Failed to delete activation codes of user %d: %v", id, err)
+			return nil, err
+		}
+		code, err = issueCode(ctx, tx, id, activationPurpose, activationTTL)
+		if err != nil {
+			log.Printf("Failed to issue activation code for user %d: %v", id, err)
+			return nil, err
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		log.Printf("Failed to commit transaction: %v", err)
+		return nil, err
+	}
+	if emailChanged {
+		s.sendActivationMail(ctx, user, code)
+	}
+	broker.PublishEvent(ctx, s.events, userEventsExchange, userUpdated, user)
+
+	return user, nil
+}
+
+// userCursor is the decoded form of a ListUsers page token. Users are
+// listed by id, so the id of the last user returned is enough to resume;
+// the fingerprint of the request ties the token to its filters.
+type userCursor struct {
+	Query string `json:"q"`
+	ID    int32  `json:"i"`
+}
+
+func encodeUserCursor(c userCursor) (string, error) {
+	data, err := json.Marshal(c)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+func decodeUserCursor(token string) (userCursor, error) {
+	var c userCursor
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return c, err
+	}
+	err = json.Unmarshal(data, &c)
+	return c, err
+}
+
+func (s *server) ListUsers(ctx context.Context, req *pb.ListUsersRequest) (*pb.ListUsersResponse, error) {
+	pageSize := int(req.GetPageSize())
+	if pageSize <= 0 {
+		pageSize = defaultPageSize
+	}
+	if pageSize > maxPageSize {
+		pageSize = maxPageSize
+	}
+
+	var conditions []string
+	var args []interface{}
+	arg := func(value interface{}) string {
+		args = append(args, value)
+		return fmt.Sprintf("$%d", len(args))
+	}
+
+	// Build the filters from the fields that are set
+	if email := req.GetEmail(); email != "" {
+		conditions = append(conditions, fmt.Sprintf("email ILIKE %s", arg("%"+escapeLike(email)+"%")))
+	}
+	if role := req.GetRole(); role != "" {
+		conditions = append(conditions, fmt.Sprintf(
+			"EXISTS (SELECT 1 FROM user_roles WHERE user_id = users.id AND role = %s)", arg(role)))
+	}
+	if activated := req.GetActivated(); activated != nil {
+		conditions = append(conditions, fmt.Sprintf("activated = %s", arg(activated.GetValue())))
+	}
+
+	// Resume after the last user of the previous page, as long as the token
+	// was issued for the same filters
+	query := pagetoken.Fingerprint(req)
+	if token := req.GetPageToken(); token != "" {
+		cursor, err := decodeUserCursor(token)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid page_token")
+		}
+		if cursor.Query != query {
+			return nil, status.Error(codes.InvalidArgument, "page_token was issued for different filters")
+		}
+		conditions = append(conditions, fmt.Sprintf("id > %s", arg(cursor.ID)))
+	}
+
+	// Prepare the SQL statement. One extra row is fetched to tell whether
+	// another page follows
+	stmt := `SELECT ` + userColumns + ` FROM users`
+	if len(conditions) > 0 {
+		stmt += " WHERE " + strings.Join(conditions, " AND ")
+	}
+	stmt += fmt.Sprintf(" ORDER BY id LIMIT %d", pageSize+1)
+
+	// Execute the SQL statement
+	rows, err := s.db.Query(ctx, stmt, args...)
+	if err != nil {
+		log.Printf("Failed to list users: %v", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	var users []*pb.User
+	for rows.Next() {
+		user := &pb.User{}
+		if err := rows.Scan(userScanFields(user)...); err != nil {
+			log.Printf("Failed to scan user: %v", err)
+			return nil, err
+		}
+		users = append(users, user)
+	}
+	if err := rows.Err(); err != nil {
+		log.Printf("Failed to list users: %v", err)
+		return nil, err
+	}
+
+	response := &pb.ListUsersResponse{Users: users}
+	if len(users) > pageSize {
+		response.Users = users[:pageSize]
+		token, err := encodeUserCursor(userCursor{Query: query, ID: users[pageSize-1].Id})
+		if err != nil {
+			log.Printf("Failed to encode page token: %v", err)
+			return nil, err
+		}
+		response.NextPageToken = token
+	}
+	return response, nil
+}
+
+// escapeLike makes s match itself in a LIKE pattern.
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(s)
+}
+
+func (s *server) DeleteUser(ctx context.Context, req *pb.DeleteUserRequest) (*pb.DeleteUserResponse, error) {
+	id := req.GetId()
+	if err := authorizeUser(ctx, id, auth.PermissionUsersWrite); err != nil {
+		return nil, err
+	}
+
+	// Prepare the SQL statement. Codes and sessions of the user go with
+	// it, which logs it out everywhere
+	stmt := `DELETE FROM users WHERE id = $1 RETURNING ` + userColumns
+
+	// Execute the SQL statement, keeping the deleted row for the event
+	user := &pb.User{}
+	err := s.db.QueryRow(ctx, stmt, id).Scan(userScanFields(user)...)
+	if err != nil {
+		if !errors.Is(err, pgx.ErrNoRows) {
+			log.Printf("Failed to delete user %d: %v", id, err)
+		}
+		return nil, err
+	}
+	broker.PublishEvent(ctx, s.events, userEventsExchange, userDeleted, user)
+
+	return &pb.DeleteUserResponse{Success: true}, nil
+}
@@ -0,0 +1,68 @@
+package totp
+
+import (
+	"testing"
+	"time"
+)
+
+// rfcSecret is the SHA-1 key of the RFC 6238 test vectors.
+var rfcSecret = []byte("12345678901234567890")
+
+func TestCode(t *testing.T) {
+	// The last six digits of the RFC 6238 appendix B SHA-1 vectors
+	tests := []struct {
+		unix int64
+		want string
+	}{
+		{59, "287082"},
+		{1111111109, "081804"},
+		{1111111111, "050471"},
+		{1234567890, "005924"},
+		{2000000000, "279037"},
+	}
+	for _, tt := range tests {
+		if got := Code(rfcSecret, Step(time.Unix(tt.unix, 0))); got != tt.want {
+			t.Errorf("Code at %d = %s, want %s", tt.unix, got, tt.want)
+		}
+	}
+}
+
+func TestMatch(t *testing.T) {
+	now := time.Unix(1234567890, 0)
+	step := Step(now)
+	tests := []struct {
+		name   string
+		offset int64
+		skew   int
+		ok     bool
+	}{
+		{"current step", 0, 0, true},
+		{"previous step without skew", -1, 0, false},
+		{"previous step", -1, 1, true},
+		{"next step", 1, 1, true},
+		{"edge of window", -2, 2, true},
+		{"past the window", -2, 1, false},
+		{"future past the window", 2, 1, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			code := Code(rfcSecret, step+tt.offset)
+			got, ok := Match(rfcSecret, code, now, tt.skew)
+			if ok != tt.ok {
+				t.Fatalf("Match(%s, skew %d) ok = %v, want %v", code, tt.skew, ok, tt.ok)
+			}
+			if ok && got != step+tt.offset {
+				t.Errorf("Match(%s, skew %d) step = %d, want %d", code, tt.skew, got, step+tt.offset)
+			}
+		})
+	}
+}
+
+func TestMatchRejectsMalformedCodes(t *testing.T) {
+	now := time.Unix(59, 0)
+	for _, code := range []string{"", "28708", "2870820", "94287082"} {
+		if _, ok := Match(rfcSecret, code, now, 1); ok {
+			t.Errorf("Match(%q) = true, want false", code)
+		}
+	}
+}